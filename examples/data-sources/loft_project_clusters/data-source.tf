@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# List the clusters instances of the default project may run on
+data "loft_project_clusters" "default" {
+  project = "default"
+}
+
+output "clusters" {
+  value = data.loft_project_clusters.default.clusters.*.name
+}
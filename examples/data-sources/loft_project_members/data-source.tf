@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+data "loft_project_members" "default" {
+  project = "default"
+}
+
+# Output the email addresses of all project admins
+output "admin_emails" {
+  value = [
+    for user in data.loft_project_members.default.users : user.email
+    if contains(user.cluster_roles, "loft-management-project-admin")
+  ]
+}
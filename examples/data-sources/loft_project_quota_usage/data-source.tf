@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# Read the quota usage of the default project
+data "loft_project_quota_usage" "default" {
+  project = "default"
+}
+
+output "project_used" {
+  value = data.loft_project_quota_usage.default.project_used
+}
+
+output "spaces_per_user" {
+  value = {
+    for user in data.loft_project_quota_usage.default.users : user.name => lookup(user.used, "spaceinstances", "0")
+  }
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# Look up the templates the default project may use
+data "loft_project_templates" "default" {
+  project = "default"
+}
+
+# Create a space instance from the default space template of the project
+resource "loft_space_instance" "my-space" {
+  metadata {
+    namespace = "loft-p-default"
+    name      = "my-space"
+  }
+  spec {
+    template_ref {
+      name = data.loft_project_templates.default.default_space_template
+    }
+  }
+}
+
+output "space_templates" {
+  value = data.loft_project_templates.default.space_templates.*.name
+}
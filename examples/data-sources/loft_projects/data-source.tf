@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# List all projects owned by the admin user
+data "loft_projects" "admin" {
+  owner_user = "admin"
+}
+
+output "projects" {
+  value = data.loft_projects.admin.projects.*.metadata.0.name
+}
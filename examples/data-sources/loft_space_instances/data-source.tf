@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# List all production space instances of the default project
+data "loft_space_instances" "prod" {
+  namespace      = "loft-p-default"
+  label_selector = "env=prod"
+}
+
+output "spaces" {
+  value = data.loft_space_instances.prod.space_instances.*.metadata.0.name
+}
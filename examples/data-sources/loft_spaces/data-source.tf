@@ -17,3 +17,15 @@ data "loft_spaces" "all" {
 output "spaces" {
   value = data.loft_spaces.all.spaces.*.name
 }
+
+# Import data for the production spaces of the admin user
+data "loft_spaces" "admin_prod" {
+  cluster        = "loft-cluster"
+  user           = "admin"
+  label_selector = "env=prod"
+}
+
+# Output when the production spaces go to sleep
+output "sleep_after" {
+  value = { for space in data.loft_spaces.admin_prod.spaces : space.name => space.sleep_after }
+}
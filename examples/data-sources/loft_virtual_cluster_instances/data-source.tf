@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# List all virtual cluster instances created from the isolated-vcluster template
+data "loft_virtual_cluster_instances" "isolated" {
+  template = "isolated-vcluster"
+}
+
+output "virtual_clusters" {
+  value = data.loft_virtual_cluster_instances.isolated.virtual_cluster_instances.*.metadata.0.name
+}
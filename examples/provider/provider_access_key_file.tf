@@ -0,0 +1,6 @@
+provider "loft" {
+  host = "https://loft.example.com"
+
+  # Read again whenever the file changes or the key is rejected
+  access_key_file = "/var/run/secrets/loft/access-key"
+}
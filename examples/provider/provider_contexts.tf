@@ -0,0 +1,29 @@
+# $HOME/.loft/config.json
+# {
+#   "host": "https://loft.example.com",
+#   "accesskey": "...",
+#   "contexts": {
+#     "eu": { "config": "~/.loft/eu.json" },
+#     "us": { "host": "https://loft-us.example.com", "accesskey": "..." }
+#   }
+# }
+#
+# $HOME/.loft/eu.json is written by `loft login https://loft-eu.example.com --config ~/.loft/eu.json`
+
+provider "loft" {
+  alias   = "eu"
+  context = "eu"
+}
+
+provider "loft" {
+  alias   = "us"
+  context = "us"
+}
+
+resource "loft_project" "eu" {
+  provider = loft.eu
+
+  metadata {
+    name = "eu-project"
+  }
+}
@@ -0,0 +1,11 @@
+provider "loft" {
+  # Added to every project, space and virtual cluster, labels set on a
+  # resource take precedence
+  default_labels = {
+    "cost-center" = "1234"
+    "team"        = "platform"
+  }
+  default_annotations = {
+    "example.com/managed-by" = "terraform"
+  }
+}
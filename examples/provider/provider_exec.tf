@@ -0,0 +1,12 @@
+provider "loft" {
+  host = "https://loft.example.com"
+
+  # Prints {"accessKey": "...", "expirationTimestamp": "..."} to stdout
+  exec {
+    command = "loft-token-broker"
+    args    = ["issue", "--audience", "loft"]
+    env = {
+      BROKER_ROLE = "terraform"
+    }
+  }
+}
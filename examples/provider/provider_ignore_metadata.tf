@@ -0,0 +1,6 @@
+provider "loft" {
+  # Annotations and labels set by Argo CD are not read back, so they
+  # neither show up as drift nor get removed on the next apply
+  ignore_annotations = ["^argocd\\.argoproj\\.io/"]
+  ignore_labels      = ["^app\\.kubernetes\\.io/managed-by$"]
+}
@@ -0,0 +1,5 @@
+# import the `auditors` access rule of the `shared` project
+terraform import loft_project_access.auditors shared/auditors
+
+# import the `platform` access rule of the `platform` space instance in the `shared` project
+terraform import loft_project_access.platform-space shared/space_instance/platform/platform
\ No newline at end of file
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# A central security module grants the auditors read access to the project
+resource "loft_project_access" "auditors" {
+  project      = "shared"
+  name         = "auditors"
+  verbs        = ["get"]
+  subresources = ["*"]
+  teams        = ["auditors"]
+}
+
+# while a team module grants its own members access to one of its spaces
+resource "loft_project_access" "platform-space" {
+  project        = "shared"
+  space_instance = "platform"
+  name           = "platform"
+  verbs          = ["use", "get", "update"]
+  subresources   = ["*"]
+  teams          = ["platform"]
+}
@@ -0,0 +1,2 @@
+# import the `gpu-cluster` allowed cluster of the `shared` project into the `loft_project_allowed_cluster.gpu` resource
+terraform import loft_project_allowed_cluster.gpu shared/gpu-cluster
\ No newline at end of file
@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# The owners of the gpu-cluster grant it to the shared project
+# from their own workspace
+resource "loft_project_allowed_cluster" "gpu" {
+  project = "shared"
+  cluster = "gpu-cluster"
+}
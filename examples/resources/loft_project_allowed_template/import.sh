@@ -0,0 +1,2 @@
+# import the `isolated-vcluster` allowed template of the `shared` project into the `loft_project_allowed_template.isolated-vcluster` resource
+terraform import loft_project_allowed_template.isolated-vcluster shared/VirtualClusterTemplate/isolated-vcluster
\ No newline at end of file
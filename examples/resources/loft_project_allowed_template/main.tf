@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+resource "loft_project_allowed_template" "isolated-vcluster" {
+  project    = "shared"
+  kind       = "VirtualClusterTemplate"
+  name       = "isolated-vcluster"
+  is_default = true
+}
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+resource "loft_project_import_space" "example-space" {
+  project = "example-project"
+  cluster = "loft-cluster"
+  space   = "example-space"
+}
+
+# The resulting space instance can be imported into a loft_space_instance resource
+output "space_instance_id" {
+  value = loft_project_import_space.example-space.instance_id
+}
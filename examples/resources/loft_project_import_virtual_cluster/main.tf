@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+resource "loft_project_import_virtual_cluster" "example-vcluster" {
+  project         = "example-project"
+  cluster         = "loft-cluster"
+  namespace       = "vcluster-example"
+  virtual_cluster = "example-vcluster"
+}
+
+# The resulting virtual cluster instance can be imported into a loft_virtual_cluster_instance resource
+output "virtual_cluster_instance_id" {
+  value = loft_project_import_virtual_cluster.example-vcluster.instance_id
+}
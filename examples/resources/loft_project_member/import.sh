@@ -0,0 +1,2 @@
+# import the `platform` team member of the `shared` project into the `loft_project_member.platform` resource
+terraform import loft_project_member.platform shared/Team/platform
\ No newline at end of file
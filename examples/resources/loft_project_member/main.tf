@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    loft = {
+      source = "registry.terraform.io/loft-sh/loft"
+    }
+  }
+}
+
+provider "loft" {}
+
+# Each team module adds itself to the shared project without
+# touching the members added by other modules
+resource "loft_project_member" "platform" {
+  project      = "shared"
+  kind         = "Team"
+  name         = "platform"
+  cluster_role = "loft-management-project-admin"
+}
+
+resource "loft_project_member" "jane" {
+  project      = "shared"
+  kind         = "User"
+  name         = "jane"
+  cluster_role = "loft-management-project-user"
+}
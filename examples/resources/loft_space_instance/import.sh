@@ -1,2 +1,7 @@
 # import the `example-space`, located in the `loft-p-example-project` namespace into the `loft_space_instance.example-space` resource
 terraform import loft_space_instance.example-space loft-p-example-project/example-space
+
+# migrate the legacy `loft_space.example-space` on cluster `loft-cluster` into the `example-project` project:
+# import the space into the project with a `loft_project_import_space` resource first, then import the resulting space instance into state
+terraform state rm loft_space.example-space
+terraform import loft_space_instance.example-space example-project/loft-cluster/example-space
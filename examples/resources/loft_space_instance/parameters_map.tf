@@ -0,0 +1,20 @@
+resource "loft_space_instance" "example-space" {
+  metadata {
+    namespace = "loft-p-example-project"
+    name      = "example-space"
+  }
+  spec {
+    owner {
+      user = "admin"
+    }
+    template_ref {
+      name = "example-template"
+    }
+    parameters_map = {
+      "quota.cpu"      = "4"
+      "quota.memory"   = "8Gi"
+      "enable-ingress" = "true"
+      "cost-center"    = "'1234'"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+resource "loft_space_instance" "example-space" {
+  metadata {
+    namespace = "loft-p-example-project"
+    name      = "example-space"
+  }
+  spec {
+    owner {
+      user = "admin"
+    }
+    template_ref {
+      name = "isolated-space"
+    }
+    sleep_after     = "1h"
+    delete_after    = "720h"
+    sleep_schedule  = "0 18 * * 1-5"
+    wakeup_schedule = "0 8 * * 1-5"
+  }
+}
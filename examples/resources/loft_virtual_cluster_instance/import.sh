@@ -1,2 +1,7 @@
 # import the `example-vcluster`, located in the `loft-p-example-project` namespace into the `loft_virtual_cluster_instance.example-vcluster` resource
 terraform import loft_virtual_cluster_instance.example-vcluster loft-p-example-project/example-vcluster
+
+# migrate the legacy `loft_virtual_cluster.example-vcluster` in namespace `vcluster-ns` on cluster `loft-cluster` into the `example-project` project:
+# import the virtual cluster into the project with a `loft_project_import_virtual_cluster` resource first, then import the resulting virtual cluster instance into state
+terraform state rm loft_virtual_cluster.example-vcluster
+terraform import loft_virtual_cluster_instance.example-vcluster example-project/loft-cluster/vcluster-ns/example-vcluster
@@ -0,0 +1,19 @@
+resource "loft_virtual_cluster_instance" "example-vcluster" {
+  metadata {
+    namespace = "loft-p-example-project"
+    name      = "example-vcluster"
+  }
+  spec {
+    owner {
+      user = "admin"
+    }
+    template_ref {
+      name = "isolated-vcluster"
+    }
+    parameters_map = {
+      "k8sVersion"         = "v1.26"
+      "resources.replicas" = "2"
+      "sleepAfter"         = "'3600'"
+    }
+  }
+}
@@ -0,0 +1,17 @@
+resource "loft_virtual_cluster_instance" "example-vcluster" {
+  metadata {
+    namespace = "loft-p-example-project"
+    name      = "example-vcluster"
+  }
+  spec {
+    owner {
+      user = "admin"
+    }
+    template_ref {
+      name = "isolated-vcluster"
+    }
+    sleep_after     = "1h"
+    sleep_schedule  = "CRON_TZ=Europe/Berlin 0 18 * * 1-5"
+    wakeup_schedule = "CRON_TZ=Europe/Berlin 0 8 * * 1-5"
+  }
+}
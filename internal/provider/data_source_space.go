@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -42,10 +43,10 @@ func dataSourceSpaceRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	space, err := clusterClient.Agent().ClusterV1().Spaces().Get(ctx, spaceName, metav1.GetOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "read", "loft_space", generateSpaceID(clusterName, spaceName))
 	}
 
-	err = readSpace(clusterName, space, d)
+	err = readSpace(clusterName, space, d, utils.MetadataOptions{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/cluster/v1"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -16,7 +17,7 @@ import (
 func DataSourceSpaces() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
-		Description: "The `loft_spaces` data source provides information about all Loft spaces in the given `cluster`.",
+		Description: "The `loft_spaces` data source provides information about all Loft spaces in the given `cluster`, optionally filtered by labels and owner.",
 
 		DeprecationMessage: "`loft_spaces` has been deprecated and will be removed in a future release.",
 
@@ -34,6 +35,23 @@ func DataSourceSpaces() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"label_selector": {
+				Description: "Only list spaces whose labels match this [label selector](https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors), e.g. `env=prod,tier!=cache`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"user": {
+				Description:   "Only list spaces owned by this user.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"team"},
+			},
+			"team": {
+				Description:   "Only list spaces owned by this team.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user"},
+			},
 		},
 	}
 }
@@ -53,14 +71,27 @@ func dataSourceSpacesRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	spacesList, err := clusterClient.Agent().ClusterV1().Spaces().List(ctx, metav1.ListOptions{})
+	listOptions := metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+	}
+	spacesList, err := clusterClient.Agent().ClusterV1().Spaces().List(ctx, listOptions)
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "list", "loft_spaces", clusterName)
 	}
 
+	user := d.Get("user").(string)
+	team := d.Get("team").(string)
+
 	var spaces []map[string]interface{}
 	for _, space := range spacesList.Items {
-		flattenedSpace, err := flattenSpace(clusterName, space)
+		if user != "" && space.Spec.User != user {
+			continue
+		}
+		if team != "" && space.Spec.Team != team {
+			continue
+		}
+
+		flattenedSpace, err := flattenSpace(clusterName, space, utils.MetadataOptions{})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -75,22 +106,39 @@ func dataSourceSpacesRead(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
-func flattenSpace(clusterName string, space v1.Space) (map[string]interface{}, error) {
+func flattenSpace(clusterName string, space v1.Space, options utils.MetadataOptions) (map[string]interface{}, error) {
 	flattenedSpace := map[string]interface{}{
-		"name":    space.GetName(),
-		"cluster": clusterName,
-		"user":    space.Spec.User,
-		"team":    space.Spec.Team,
-		"objects": space.Spec.Objects,
+		"id":            generateSpaceID(clusterName, space.GetName()),
+		"name":          space.GetName(),
+		"generate_name": space.GetGenerateName(),
+		"cluster":       clusterName,
+		"user":          space.Spec.User,
+		"team":          space.Spec.Team,
+		"objects":       space.Spec.Objects,
 	}
 
-	rawAnnotations := removeInternalKeys(space.GetAnnotations(), map[string]interface{}{})
-	annotations, err := mapToAttributes(rawAnnotations)
+	rawAnnotations := space.GetAnnotations()
+	flattenedSpace["sleep_after"] = rawAnnotations[v1.SleepModeSleepAfterAnnotation]
+	flattenedSpace["delete_after"] = rawAnnotations[v1.SleepModeDeleteAfterAnnotation]
+	flattenedSpace["sleep_schedule"] = rawAnnotations[v1.SleepModeSleepScheduleAnnotation]
+	flattenedSpace["wakeup_schedule"] = rawAnnotations[v1.SleepModeWakeupScheduleAnnotation]
+
+	annotations, err := mapToAttributes(options.ReadAnnotations(rawAnnotations, nil))
 	if err != nil {
 		return nil, err
 	}
-
 	flattenedSpace["annotations"] = annotations
 
+	rawLabels := space.GetLabels()
+	if rawLabels[SpaceLabelSpaceConstraints] != DefaultSpaceConstraints {
+		flattenedSpace["space_constraints"] = rawLabels[SpaceLabelSpaceConstraints]
+	}
+
+	labels, err := mapToAttributes(options.ReadLabels(rawLabels, nil))
+	if err != nil {
+		return nil, err
+	}
+	flattenedSpace["labels"] = labels
+
 	return flattenedSpace, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -43,10 +44,10 @@ func dataSourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, m
 
 	virtualCluster, err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).Get(ctx, virtualClusterName, metav1.GetOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "read", "loft_virtual_cluster", generateVirtualClusterId(clusterName, namespace, virtualClusterName))
 	}
 
-	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d); err != nil {
+	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d, utils.MetadataOptions{}); err != nil {
 		return diag.FromErr(err)
 	}
 
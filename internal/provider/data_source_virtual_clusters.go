@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/storage/v1"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -39,6 +40,11 @@ func DataSourceVirtualClusters() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"label_selector": {
+				Description: "Only list virtual clusters whose labels match this [label selector](https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors), e.g. `env=prod,tier!=cache`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -59,14 +65,17 @@ func dataSourceVirtualClustersRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	virtualClustersList, err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).List(ctx, metav1.ListOptions{})
+	listOptions := metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+	}
+	virtualClustersList, err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).List(ctx, listOptions)
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "list", "loft_virtual_clusters", clusterName+"/"+namespace)
 	}
 
 	var virtualClusters []map[string]interface{}
 	for _, virtualCluster := range virtualClustersList.Items {
-		flattenedVirtualCluster, err := flattenVirtualCluster(clusterName, namespace, virtualCluster)
+		flattenedVirtualCluster, err := flattenVirtualCluster(clusterName, namespace, virtualCluster, utils.MetadataOptions{})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -82,21 +91,30 @@ func dataSourceVirtualClustersRead(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func flattenVirtualCluster(clusterName, namespace string, virtualCluster v1.VirtualCluster) (map[string]interface{}, error) {
+func flattenVirtualCluster(clusterName, namespace string, virtualCluster v1.VirtualCluster, options utils.MetadataOptions) (map[string]interface{}, error) {
 	flattenedVirtualCluster := map[string]interface{}{
-		"name":      virtualCluster.GetName(),
-		"cluster":   clusterName,
-		"namespace": namespace,
-		"objects":   virtualCluster.Spec.Objects,
+		"id":            generateVirtualClusterId(clusterName, namespace, virtualCluster.GetName()),
+		"name":          virtualCluster.GetName(),
+		"generate_name": virtualCluster.GetGenerateName(),
+		"cluster":       clusterName,
+		"namespace":     namespace,
+		"objects":       virtualCluster.Spec.Objects,
+		"chart_name":    virtualCluster.Spec.HelmRelease.Chart.Name,
+		"chart_version": virtualCluster.Spec.HelmRelease.Chart.Version,
+		"values":        virtualCluster.Spec.HelmRelease.Values,
 	}
 
-	rawAnnotations := removeInternalKeys(virtualCluster.GetAnnotations(), map[string]interface{}{})
-	annotations, err := mapToAttributes(rawAnnotations)
+	annotations, err := mapToAttributes(options.ReadAnnotations(virtualCluster.GetAnnotations(), nil))
 	if err != nil {
 		return nil, err
 	}
-
 	flattenedVirtualCluster["annotations"] = annotations
 
+	labels, err := mapToAttributes(options.ReadLabels(virtualCluster.GetLabels(), nil))
+	if err != nil {
+		return nil, err
+	}
+	flattenedVirtualCluster["labels"] = labels
+
 	return flattenedVirtualCluster, nil
 }
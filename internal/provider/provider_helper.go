@@ -2,12 +2,13 @@ package provider
 
 import (
 	"fmt"
-	"net/url"
-	"strings"
 )
 
-func attributesToMap(rawMap map[string]interface{}) (map[string]string, error) {
+func attributesToMap(rawMap map[string]interface{}, defaults map[string]string) (map[string]string, error) {
 	strMap := map[string]string{}
+	for k, v := range defaults {
+		strMap[k] = v
+	}
 	for k, v := range rawMap {
 		str, ok := v.(string)
 		if !ok {
@@ -26,56 +27,6 @@ func mapToAttributes(rawMap map[string]string) (map[string]interface{}, error) {
 	return attr, nil
 }
 
-func removeInternalKeys(m map[string]string, d map[string]interface{}) map[string]string {
-	for k := range m {
-		if isInternalKey(k) && !isKeyInMap(k, d) {
-			delete(m, k)
-		}
-	}
-	return m
-}
-
-func isKeyInMap(key string, d map[string]interface{}) bool {
-	if d == nil {
-		return false
-	}
-	for k := range d {
-		if k == key {
-			return true
-		}
-	}
-	return false
-}
-
-func isInternalKey(annotationKey string) bool {
-	u, err := url.Parse("//" + annotationKey)
-	if err != nil {
-		return false
-	}
-
-	// allow user specified application specific keys
-	if u.Hostname() == "app.kubernetes.io" {
-		return false
-	}
-
-	// internal *.kubernetes.io keys
-	if strings.HasSuffix(u.Hostname(), "kubernetes.io") {
-		return true
-	}
-
-	// Specific to DaemonSet annotations, generated & controlled by the server.
-	if strings.Contains(annotationKey, "deprecated.daemonset.templates.generation") {
-		return true
-	}
-
-	// internal *.loft.sh keys
-	if strings.HasSuffix(u.Hostname(), "loft.sh") {
-		return true
-	}
-
-	return false
-}
-
 func getAddedModifiedAndDeleted(old map[string]interface{}, new map[string]interface{}) (map[string]interface{}, map[string]interface{}, map[string]interface{}, error) {
 	added := map[string]interface{}{}
 	modified := map[string]interface{}{}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -11,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+
 	agentv1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/cluster/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -61,7 +65,7 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	rawAnnotations := d.Get("annotations").(map[string]interface{})
-	annotations, err := attributesToMap(rawAnnotations)
+	annotations, err := attributesToMap(rawAnnotations, utils.ProviderMetadataOptions(meta).DefaultAnnotations)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -99,7 +103,7 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	space.SetAnnotations(annotations)
 
 	rawLabels := d.Get("labels").(map[string]interface{})
-	labels, err := attributesToMap(rawLabels)
+	labels, err := attributesToMap(rawLabels, utils.ProviderMetadataOptions(meta).DefaultLabels)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -132,10 +136,10 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	space, err = clusterClient.Agent().ClusterV1().Spaces().Create(ctx, space, metav1.CreateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "create", "loft_space", generateSpaceID(clusterName, name+generateName))
 	}
 
-	err = readSpace(clusterName, space, d)
+	err = readSpace(clusterName, space, d, utils.ProviderMetadataOptions(meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -157,10 +161,10 @@ func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	space, err := clusterClient.Agent().ClusterV1().Spaces().Get(ctx, spaceName, metav1.GetOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "read", "loft_space", d.Id())
 	}
 
-	err = readSpace(clusterName, space, d)
+	err = readSpace(clusterName, space, d, utils.ProviderMetadataOptions(meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -181,168 +185,174 @@ func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	oldSpace, err := clusterClient.Agent().ClusterV1().Spaces().Get(ctx, spaceName, metav1.GetOptions{})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	modifiedSpace := oldSpace.DeepCopy()
-
-	if d.HasChange("user") {
-		_, newUser := d.GetChange("user")
-		modifiedSpace.Spec.User = newUser.(string)
-	}
-
-	if d.HasChange("team") {
-		_, newTeam := d.GetChange("team")
-		modifiedSpace.Spec.Team = newTeam.(string)
-	}
-
-	if d.HasChange("objects") {
-		_, newObjects := d.GetChange("objects")
-		modifiedSpace.Spec.Objects = newObjects.(string)
-	}
-
-	if d.HasChange("annotations") {
-		oldAnnotations, newAnnotations := d.GetChange("annotations")
-
-		added, modified, deleted, err := getAddedModifiedAndDeleted(
-			oldAnnotations.(map[string]interface{}),
-			newAnnotations.(map[string]interface{}),
-		)
-
+	var space *agentv1.Space
+	err = loftclient.RetryOnConflict(meta, func() error {
+		oldSpace, err := clusterClient.Agent().ClusterV1().Spaces().Get(ctx, spaceName, metav1.GetOptions{})
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
-		for k, v := range added {
-			modifiedSpace.Annotations[k] = v.(string)
+		modifiedSpace := oldSpace.DeepCopy()
+
+		if d.HasChange("user") {
+			_, newUser := d.GetChange("user")
+			modifiedSpace.Spec.User = newUser.(string)
 		}
 
-		for k, v := range modified {
-			modifiedSpace.Annotations[k] = v.(string)
+		if d.HasChange("team") {
+			_, newTeam := d.GetChange("team")
+			modifiedSpace.Spec.Team = newTeam.(string)
 		}
 
-		for k := range deleted {
-			delete(modifiedSpace.Annotations, k)
+		if d.HasChange("objects") {
+			_, newObjects := d.GetChange("objects")
+			modifiedSpace.Spec.Objects = newObjects.(string)
 		}
-	}
 
-	if d.HasChange("labels") {
-		oldLabels, newLabels := d.GetChange("labels")
+		if d.HasChange("annotations") {
+			oldAnnotations, newAnnotations := d.GetChange("annotations")
 
-		added, modified, deleted, err := getAddedModifiedAndDeleted(
-			oldLabels.(map[string]interface{}),
-			newLabels.(map[string]interface{}),
-		)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+			added, modified, deleted, err := getAddedModifiedAndDeleted(
+				oldAnnotations.(map[string]interface{}),
+				newAnnotations.(map[string]interface{}),
+			)
 
-		for k, v := range added {
-			modifiedSpace.Labels[k] = v.(string)
-		}
+			if err != nil {
+				return err
+			}
 
-		for k, v := range modified {
-			modifiedSpace.Labels[k] = v.(string)
-		}
+			for k, v := range added {
+				modifiedSpace.Annotations[k] = v.(string)
+			}
 
-		for k := range deleted {
-			delete(modifiedSpace.Labels, k)
-		}
-	}
+			for k, v := range modified {
+				modifiedSpace.Annotations[k] = v.(string)
+			}
 
-	if d.HasChange("sleep_after") {
-		_, newSleepAfter := d.GetChange("sleep_after")
-		sleepAfter, ok := newSleepAfter.(string)
-		if !ok {
-			return diag.Errorf("sleep_after value is not a string")
+			for k := range deleted {
+				delete(modifiedSpace.Annotations, k)
+			}
+			modifiedSpace.Annotations = utils.ApplyDefaults(modifiedSpace.Annotations, newAnnotations.(map[string]interface{}), utils.ProviderMetadataOptions(meta).DefaultAnnotations)
 		}
 
-		if sleepAfter != "" {
-			duration, err := time.ParseDuration(sleepAfter)
+		if d.HasChange("labels") {
+			oldLabels, newLabels := d.GetChange("labels")
+
+			added, modified, deleted, err := getAddedModifiedAndDeleted(
+				oldLabels.(map[string]interface{}),
+				newLabels.(map[string]interface{}),
+			)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 
-			modifiedSpace.Annotations[agentv1.SleepModeSleepAfterAnnotation] = strconv.Itoa(int(duration.Seconds()))
-		} else {
-			delete(modifiedSpace.Annotations, agentv1.SleepModeSleepAfterAnnotation)
-		}
-	}
+			for k, v := range added {
+				modifiedSpace.Labels[k] = v.(string)
+			}
 
-	if d.HasChange("delete_after") {
-		_, newDeleteAfter := d.GetChange("delete_after")
-		deleteAfter, ok := newDeleteAfter.(string)
-		if !ok {
-			return diag.Errorf("delete_after value is not an integer")
-		}
+			for k, v := range modified {
+				modifiedSpace.Labels[k] = v.(string)
+			}
 
-		if deleteAfter != "" {
-			duration, err := time.ParseDuration(deleteAfter)
-			if err != nil {
-				return diag.FromErr(err)
+			for k := range deleted {
+				delete(modifiedSpace.Labels, k)
 			}
-			modifiedSpace.Annotations[agentv1.SleepModeDeleteAfterAnnotation] = strconv.Itoa(int(duration.Seconds()))
-		} else {
-			delete(modifiedSpace.Annotations, agentv1.SleepModeDeleteAfterAnnotation)
+			modifiedSpace.Labels = utils.ApplyDefaults(modifiedSpace.Labels, newLabels.(map[string]interface{}), utils.ProviderMetadataOptions(meta).DefaultLabels)
 		}
-	}
 
-	if d.HasChange("sleep_schedule") {
-		_, newSleepSchedule := d.GetChange("sleep_schedule")
-		sleepSchedule, ok := newSleepSchedule.(string)
-		if !ok {
-			return diag.Errorf("sleep_schedule value is not a string")
-		}
+		if d.HasChange("sleep_after") {
+			_, newSleepAfter := d.GetChange("sleep_after")
+			sleepAfter, ok := newSleepAfter.(string)
+			if !ok {
+				return fmt.Errorf("sleep_after value is not a string")
+			}
 
-		if sleepSchedule != "" {
-			modifiedSpace.Annotations[agentv1.SleepModeSleepScheduleAnnotation] = sleepSchedule
-		} else {
-			delete(modifiedSpace.Annotations, agentv1.SleepModeSleepScheduleAnnotation)
+			if sleepAfter != "" {
+				duration, err := time.ParseDuration(sleepAfter)
+				if err != nil {
+					return err
+				}
+
+				modifiedSpace.Annotations[agentv1.SleepModeSleepAfterAnnotation] = strconv.Itoa(int(duration.Seconds()))
+			} else {
+				delete(modifiedSpace.Annotations, agentv1.SleepModeSleepAfterAnnotation)
+			}
 		}
-	}
 
-	if d.HasChange("wakeup_schedule") {
-		_, newWakeupSchedule := d.GetChange("wakeup_schedule")
-		wakeupSchedule, ok := newWakeupSchedule.(string)
-		if !ok {
-			return diag.Errorf("wakeup_schedule value is not a string")
+		if d.HasChange("delete_after") {
+			_, newDeleteAfter := d.GetChange("delete_after")
+			deleteAfter, ok := newDeleteAfter.(string)
+			if !ok {
+				return fmt.Errorf("delete_after value is not an integer")
+			}
+
+			if deleteAfter != "" {
+				duration, err := time.ParseDuration(deleteAfter)
+				if err != nil {
+					return err
+				}
+				modifiedSpace.Annotations[agentv1.SleepModeDeleteAfterAnnotation] = strconv.Itoa(int(duration.Seconds()))
+			} else {
+				delete(modifiedSpace.Annotations, agentv1.SleepModeDeleteAfterAnnotation)
+			}
 		}
 
-		if wakeupSchedule != "" {
-			modifiedSpace.Annotations[agentv1.SleepModeWakeupScheduleAnnotation] = wakeupSchedule
-		} else {
-			delete(modifiedSpace.Annotations, agentv1.SleepModeWakeupScheduleAnnotation)
+		if d.HasChange("sleep_schedule") {
+			_, newSleepSchedule := d.GetChange("sleep_schedule")
+			sleepSchedule, ok := newSleepSchedule.(string)
+			if !ok {
+				return fmt.Errorf("sleep_schedule value is not a string")
+			}
+
+			if sleepSchedule != "" {
+				modifiedSpace.Annotations[agentv1.SleepModeSleepScheduleAnnotation] = sleepSchedule
+			} else {
+				delete(modifiedSpace.Annotations, agentv1.SleepModeSleepScheduleAnnotation)
+			}
 		}
-	}
 
-	if d.HasChange("space_constraints") {
-		_, newSpaceConstraints := d.GetChange("space_constraints")
-		spaceConstraints, ok := newSpaceConstraints.(string)
-		if !ok {
-			return diag.Errorf("space_constraints value is not a string")
+		if d.HasChange("wakeup_schedule") {
+			_, newWakeupSchedule := d.GetChange("wakeup_schedule")
+			wakeupSchedule, ok := newWakeupSchedule.(string)
+			if !ok {
+				return fmt.Errorf("wakeup_schedule value is not a string")
+			}
+
+			if wakeupSchedule != "" {
+				modifiedSpace.Annotations[agentv1.SleepModeWakeupScheduleAnnotation] = wakeupSchedule
+			} else {
+				delete(modifiedSpace.Annotations, agentv1.SleepModeWakeupScheduleAnnotation)
+			}
 		}
 
-		if spaceConstraints != "" {
-			modifiedSpace.Labels[SpaceLabelSpaceConstraints] = spaceConstraints
-		} else {
-			delete(modifiedSpace.Labels, SpaceLabelSpaceConstraints)
+		if d.HasChange("space_constraints") {
+			_, newSpaceConstraints := d.GetChange("space_constraints")
+			spaceConstraints, ok := newSpaceConstraints.(string)
+			if !ok {
+				return fmt.Errorf("space_constraints value is not a string")
+			}
+
+			if spaceConstraints != "" {
+				modifiedSpace.Labels[SpaceLabelSpaceConstraints] = spaceConstraints
+			} else {
+				delete(modifiedSpace.Labels, SpaceLabelSpaceConstraints)
+			}
 		}
-	}
 
-	patch := ctrlclient.MergeFrom(oldSpace)
-	rawPatch, err := patch.Data(modifiedSpace)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+		patch := ctrlclient.MergeFromWithOptions(oldSpace, ctrlclient.MergeFromWithOptimisticLock{})
+		rawPatch, err := patch.Data(modifiedSpace)
+		if err != nil {
+			return err
+		}
 
-	space, err := clusterClient.Agent().ClusterV1().Spaces().Patch(ctx, spaceName, patch.Type(), rawPatch, metav1.PatchOptions{})
+		space, err = clusterClient.Agent().ClusterV1().Spaces().Patch(ctx, spaceName, patch.Type(), rawPatch, metav1.PatchOptions{})
+		return err
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "update", "loft_space", d.Id())
 	}
 
-	err = readSpace(clusterName, space, d)
+	err = readSpace(clusterName, space, d, utils.ProviderMetadataOptions(meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -366,7 +376,7 @@ func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	err = clusterClient.Agent().ClusterV1().Spaces().Delete(ctx, spaceName, metav1.DeleteOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "delete", "loft_space", d.Id())
 	}
 
 	return nil
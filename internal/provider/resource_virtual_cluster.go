@@ -6,6 +6,9 @@ import (
 	client "github.com/loft-sh/loftctl/v3/pkg/client"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/storage/v1"
@@ -74,7 +77,7 @@ func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	rawAnnotations := d.Get("annotations").(map[string]interface{})
-	annotations, err := attributesToMap(rawAnnotations)
+	annotations, err := attributesToMap(rawAnnotations, utils.ProviderMetadataOptions(meta).DefaultAnnotations)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -82,7 +85,7 @@ func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, m
 	virtualCluster.SetAnnotations(annotations)
 
 	rawLabels := d.Get("labels").(map[string]interface{})
-	labels, err := attributesToMap(rawLabels)
+	labels, err := attributesToMap(rawLabels, utils.ProviderMetadataOptions(meta).DefaultLabels)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -96,10 +99,10 @@ func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, m
 
 	virtualCluster, err = clusterClient.Agent().StorageV1().VirtualClusters(namespace).Create(ctx, virtualCluster, metav1.CreateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "create", "loft_virtual_cluster", generateVirtualClusterId(clusterName, namespace, name+generateName))
 	}
 
-	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d); err != nil {
+	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d, utils.ProviderMetadataOptions(meta)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -120,10 +123,10 @@ func resourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, met
 
 	virtualCluster, err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).Get(ctx, virtualClusterName, metav1.GetOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "read", "loft_virtual_cluster", d.Id())
 	}
 
-	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d); err != nil {
+	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d, utils.ProviderMetadataOptions(meta)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -143,79 +146,85 @@ func resourceVirtualClusterUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	oldVirtualCluster, err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).Get(ctx, virtualClusterName, metav1.GetOptions{})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	modifiedVirtualCluster := oldVirtualCluster.DeepCopy()
-
-	if d.HasChange("objects") {
-		_, newObjects := d.GetChange("objects")
-		modifiedVirtualCluster.Spec.Objects = newObjects.(string)
-	}
-
-	if d.HasChange("annotations") {
-		oldAnnotations, newAnnotations := d.GetChange("annotations")
-
-		added, modified, deleted, err := getAddedModifiedAndDeleted(
-			oldAnnotations.(map[string]interface{}),
-			newAnnotations.(map[string]interface{}),
-		)
-
+	var virtualCluster *agentv1.VirtualCluster
+	err = loftclient.RetryOnConflict(meta, func() error {
+		oldVirtualCluster, err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).Get(ctx, virtualClusterName, metav1.GetOptions{})
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
-		for k, v := range added {
-			modifiedVirtualCluster.Annotations[k] = v.(string)
-		}
+		modifiedVirtualCluster := oldVirtualCluster.DeepCopy()
 
-		for k, v := range modified {
-			modifiedVirtualCluster.Annotations[k] = v.(string)
+		if d.HasChange("objects") {
+			_, newObjects := d.GetChange("objects")
+			modifiedVirtualCluster.Spec.Objects = newObjects.(string)
 		}
 
-		for k := range deleted {
-			delete(modifiedVirtualCluster.Annotations, k)
-		}
-	}
+		if d.HasChange("annotations") {
+			oldAnnotations, newAnnotations := d.GetChange("annotations")
 
-	if d.HasChange("labels") {
-		oldLabels, newLabels := d.GetChange("labels")
+			added, modified, deleted, err := getAddedModifiedAndDeleted(
+				oldAnnotations.(map[string]interface{}),
+				newAnnotations.(map[string]interface{}),
+			)
 
-		added, modified, deleted, err := getAddedModifiedAndDeleted(
-			oldLabels.(map[string]interface{}),
-			newLabels.(map[string]interface{}),
-		)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+			if err != nil {
+				return err
+			}
 
-		for k, v := range added {
-			modifiedVirtualCluster.Labels[k] = v.(string)
-		}
+			for k, v := range added {
+				modifiedVirtualCluster.Annotations[k] = v.(string)
+			}
 
-		for k, v := range modified {
-			modifiedVirtualCluster.Labels[k] = v.(string)
+			for k, v := range modified {
+				modifiedVirtualCluster.Annotations[k] = v.(string)
+			}
+
+			for k := range deleted {
+				delete(modifiedVirtualCluster.Annotations, k)
+			}
+			modifiedVirtualCluster.Annotations = utils.ApplyDefaults(modifiedVirtualCluster.Annotations, newAnnotations.(map[string]interface{}), utils.ProviderMetadataOptions(meta).DefaultAnnotations)
 		}
 
-		for k := range deleted {
-			delete(modifiedVirtualCluster.Labels, k)
+		if d.HasChange("labels") {
+			oldLabels, newLabels := d.GetChange("labels")
+
+			added, modified, deleted, err := getAddedModifiedAndDeleted(
+				oldLabels.(map[string]interface{}),
+				newLabels.(map[string]interface{}),
+			)
+			if err != nil {
+				return err
+			}
+
+			for k, v := range added {
+				modifiedVirtualCluster.Labels[k] = v.(string)
+			}
+
+			for k, v := range modified {
+				modifiedVirtualCluster.Labels[k] = v.(string)
+			}
+
+			for k := range deleted {
+				delete(modifiedVirtualCluster.Labels, k)
+			}
+			modifiedVirtualCluster.Labels = utils.ApplyDefaults(modifiedVirtualCluster.Labels, newLabels.(map[string]interface{}), utils.ProviderMetadataOptions(meta).DefaultLabels)
 		}
-	}
 
-	patch := ctrlclient.MergeFrom(oldVirtualCluster)
-	rawPatch, err := patch.Data(modifiedVirtualCluster)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+		patch := ctrlclient.MergeFromWithOptions(oldVirtualCluster, ctrlclient.MergeFromWithOptimisticLock{})
+		rawPatch, err := patch.Data(modifiedVirtualCluster)
+		if err != nil {
+			return err
+		}
 
-	virtualCluster, err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).Patch(ctx, virtualClusterName, patch.Type(), rawPatch, metav1.PatchOptions{})
+		virtualCluster, err = clusterClient.Agent().StorageV1().VirtualClusters(namespace).Patch(ctx, virtualClusterName, patch.Type(), rawPatch, metav1.PatchOptions{})
+		return err
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "update", "loft_virtual_cluster", d.Id())
 	}
 
-	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d); err != nil {
+	if err := readVirtualCluster(clusterName, namespace, virtualCluster, d, utils.ProviderMetadataOptions(meta)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -238,7 +247,7 @@ func resourceVirtualClusterDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if err := clusterClient.Agent().StorageV1().VirtualClusters(namespace).Delete(ctx, virtualClusterName, metav1.DeleteOptions{}); err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "delete", "loft_virtual_cluster", d.Id())
 	}
 
 	return nil
@@ -1,14 +1,11 @@
 package provider
 
 import (
-	"fmt"
 	"strings"
-	"time"
 
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	agentv1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/cluster/v1"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 )
 
 const (
@@ -79,16 +76,16 @@ func spaceAttributes() map[string]*schema.Schema {
 			Description:      "If configured, this will tell Loft to put the space to sleep after the specified duration of inactivity. The format is a string accepted by the [time.ParseDuration](https://pkg.go.dev/time#ParseDuration) function, such as `\"1h\"`",
 			Type:             schema.TypeString,
 			Optional:         true,
-			StateFunc:        durationToSeconds,
-			ValidateDiagFunc: validateDuration,
+			StateFunc:        utils.DurationToSeconds,
+			ValidateDiagFunc: utils.ValidateDuration,
 		},
 		"delete_after": {
 			// This description is used by the documentation generator and the language server.
 			Description:      "If configured, this will tell Loft to delete the space after the specified duration of inactivity. The format is a string accepted by the [time.ParseDuration](https://pkg.go.dev/time#ParseDuration) function, such as `\"1h\"`",
 			Type:             schema.TypeString,
 			Optional:         true,
-			StateFunc:        durationToSeconds,
-			ValidateDiagFunc: validateDuration,
+			StateFunc:        utils.DurationToSeconds,
+			ValidateDiagFunc: utils.ValidateDuration,
 		},
 		"sleep_schedule": {
 			Description: "Put the space to sleep at certain times. See [crontab.guru](https://crontab.guru/) for valid configurations. This might be useful if you want to set the space sleeping over the weekend for example.",
@@ -119,15 +116,16 @@ func spaceAttributes() map[string]*schema.Schema {
 		},
 		"objects": {
 			// This description is used by the documentation generator and the language server.
-			Description: "Objects are Kubernetes style yamls that should get deployed into the space.",
-			Type:        schema.TypeString,
-			Required:    false,
-			Optional:    true,
+			Description:      "Objects are Kubernetes style yamls that should get deployed into the space.",
+			Type:             schema.TypeString,
+			Required:         false,
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 	}
 }
 
-func readSpace(clusterName string, space *agentv1.Space, d *schema.ResourceData) error {
+func readSpace(clusterName string, space *agentv1.Space, d *schema.ResourceData, options utils.MetadataOptions) error {
 	spaceName := space.GetName()
 
 	d.SetId(generateSpaceID(clusterName, spaceName))
@@ -179,7 +177,7 @@ func readSpace(clusterName string, space *agentv1.Space, d *schema.ResourceData)
 		}
 	}
 
-	safeAnnotations := removeInternalKeys(space.GetAnnotations(), map[string]interface{}{})
+	safeAnnotations := options.ReadAnnotations(space.GetAnnotations(), d.Get("annotations").(map[string]interface{}))
 	annotations, err := mapToAttributes(safeAnnotations)
 	if err != nil {
 		return err
@@ -196,7 +194,7 @@ func readSpace(clusterName string, space *agentv1.Space, d *schema.ResourceData)
 		}
 	}
 
-	safeLabels := removeInternalKeys(rawLabels, map[string]interface{}{})
+	safeLabels := options.ReadLabels(rawLabels, d.Get("labels").(map[string]interface{}))
 	labels, err := mapToAttributes(safeLabels)
 	if err != nil {
 		return err
@@ -207,28 +205,3 @@ func readSpace(clusterName string, space *agentv1.Space, d *schema.ResourceData)
 
 	return nil
 }
-
-func validateDuration(v interface{}, _ cty.Path) diag.Diagnostics {
-	valStr := v.(string)
-
-	_, err := time.ParseDuration(valStr)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func durationToSeconds(val interface{}) string {
-	valStr, ok := val.(string)
-	if !ok {
-		return ""
-	}
-
-	duration, err := time.ParseDuration(valStr)
-	if err != nil {
-		return ""
-	}
-
-	return fmt.Sprintf("%d", int(duration.Seconds()))
-}
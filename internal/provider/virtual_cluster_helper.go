@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	agentv1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/storage/v1"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 )
 
 func generateVirtualClusterId(clusterName, namespace, virtualClusterName string) string {
@@ -65,9 +66,10 @@ func virtualClusterAttributes() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"values": {
-			Description: "The helm chart values to configure the virtual cluster.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:      "The helm chart values to configure the virtual cluster.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 		"namespace": {
 			Description: "The namespace where the virtual cluster is deployed.",
@@ -86,15 +88,16 @@ func virtualClusterAttributes() map[string]*schema.Schema {
 		},
 		"objects": {
 			// This description is used by the documentation generator and the language server.
-			Description: "Objects are Kubernetes style yamls that should get deployed into the virtual cluster.",
-			Type:        schema.TypeString,
-			Required:    false,
-			Optional:    true,
+			Description:      "Objects are Kubernetes style yamls that should get deployed into the virtual cluster.",
+			Type:             schema.TypeString,
+			Required:         false,
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 	}
 }
 
-func readVirtualCluster(clusterName, namespace string, virtualCluster *agentv1.VirtualCluster, d *schema.ResourceData) error {
+func readVirtualCluster(clusterName, namespace string, virtualCluster *agentv1.VirtualCluster, d *schema.ResourceData, options utils.MetadataOptions) error {
 	virtualClusterName := virtualCluster.GetName()
 
 	d.SetId(generateVirtualClusterId(clusterName, namespace, virtualClusterName))
@@ -124,7 +127,7 @@ func readVirtualCluster(clusterName, namespace string, virtualCluster *agentv1.V
 		return err
 	}
 
-	safeAnnotations := removeInternalKeys(virtualCluster.GetAnnotations(), map[string]interface{}{})
+	safeAnnotations := options.ReadAnnotations(virtualCluster.GetAnnotations(), d.Get("annotations").(map[string]interface{}))
 	annotations, err := mapToAttributes(safeAnnotations)
 	if err != nil {
 		return err
@@ -134,7 +137,7 @@ func readVirtualCluster(clusterName, namespace string, virtualCluster *agentv1.V
 	}
 
 	rawLabels := virtualCluster.GetLabels()
-	safeLabels := removeInternalKeys(rawLabels, map[string]interface{}{})
+	safeLabels := options.ReadLabels(rawLabels, d.Get("labels").(map[string]interface{}))
 	labels, err := mapToAttributes(safeLabels)
 	if err != nil {
 		return err
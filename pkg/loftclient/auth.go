@@ -0,0 +1,207 @@
+package loftclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expirySkew renews exec credentials slightly before they expire, so a key
+// is never sent that expires while the request is in flight.
+const expirySkew = 30 * time.Second
+
+// AccessKeySource provides the access key that is sent with every Loft API
+// request. It is consulted per request so short-lived keys can be rotated
+// without reconfiguring the provider.
+type AccessKeySource interface {
+	// AccessKey returns the current access key.
+	AccessKey(ctx context.Context) (string, error)
+
+	// Invalidate discards a cached key after it has been rejected by Loft.
+	Invalidate()
+}
+
+// fileAccessKeySource reads the access key from a file and reads it again
+// whenever the file changes or Loft rejects the cached key.
+type fileAccessKeySource struct {
+	path string
+
+	m       sync.Mutex
+	key     string
+	modTime time.Time
+}
+
+func NewFileAccessKeySource(path string) AccessKeySource {
+	return &fileAccessKeySource{path: path}
+}
+
+func (s *fileAccessKeySource) AccessKey(ctx context.Context) (string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("read access key file: %w", err)
+	}
+	if s.key != "" && info.ModTime().Equal(s.modTime) {
+		return s.key, nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("read access key file: %w", err)
+	}
+
+	key := strings.TrimSpace(string(content))
+	if key == "" {
+		return "", fmt.Errorf("access key file %s is empty", s.path)
+	}
+
+	s.key = key
+	s.modTime = info.ModTime()
+	return s.key, nil
+}
+
+func (s *fileAccessKeySource) Invalidate() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.key = ""
+}
+
+// ExecConfig describes a command that prints an access key to stdout, similar
+// to kubeconfig exec credential plugins.
+type ExecConfig struct {
+	Command string
+	Args    []string
+	Env     map[string]string
+}
+
+// execCredential is the output of the exec command. Both the plain format
+//
+//	{"accessKey": "...", "expirationTimestamp": "2023-01-01T00:00:00Z"}
+//
+// and the client.authentication.k8s.io ExecCredential format are accepted.
+type execCredential struct {
+	AccessKey           string     `json:"accessKey,omitempty"`
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+
+	Status *struct {
+		Token               string     `json:"token,omitempty"`
+		ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+// execAccessKeySource runs the exec command and caches the returned key until
+// it expires or is rejected by Loft.
+type execAccessKeySource struct {
+	config ExecConfig
+
+	m       sync.Mutex
+	key     string
+	expires time.Time
+}
+
+func NewExecAccessKeySource(config ExecConfig) AccessKeySource {
+	return &execAccessKeySource{config: config}
+}
+
+func (s *execAccessKeySource) AccessKey(ctx context.Context) (string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.key != "" && (s.expires.IsZero() || time.Now().Add(expirySkew).Before(s.expires)) {
+		return s.key, nil
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, s.config.Command, s.config.Args...)
+	cmd.Env = os.Environ()
+	for k, v := range s.config.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("run exec command %s: %w: %s", s.config.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	credential := &execCredential{}
+	if err := json.Unmarshal(stdout.Bytes(), credential); err != nil {
+		return "", fmt.Errorf("parse output of exec command %s: %w", s.config.Command, err)
+	}
+
+	key, expires := credential.AccessKey, credential.ExpirationTimestamp
+	if credential.Status != nil && key == "" {
+		key, expires = credential.Status.Token, credential.Status.ExpirationTimestamp
+	}
+	if key == "" {
+		return "", fmt.Errorf("exec command %s did not return an access key", s.config.Command)
+	}
+
+	s.key = key
+	s.expires = time.Time{}
+	if expires != nil {
+		s.expires = *expires
+	}
+	return s.key, nil
+}
+
+func (s *execAccessKeySource) Invalidate() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.key = ""
+}
+
+// accessKeyTransport replaces the static access key of the rest config with
+// the current key of the source. If Loft rejects the key, the source is
+// invalidated and the request is sent once more with a fresh key.
+type accessKeyTransport struct {
+	next   http.RoundTripper
+	source AccessKeySource
+}
+
+func newAccessKeyTransport(next http.RoundTripper, source AccessKeySource) http.RoundTripper {
+	return &accessKeyTransport{
+		next:   next,
+		source: source,
+	}
+}
+
+func (t *accessKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	retryReq, ok := rewindRequest(req)
+	if !ok {
+		return resp, nil
+	}
+
+	t.source.Invalidate()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return t.roundTrip(retryReq)
+}
+
+func (t *accessKeyTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	key, err := t.source.AccessKey(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+key)
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,111 @@
+package loftclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileAccessKeySource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access-key")
+	if err := os.WriteFile(path, []byte("first\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	source := NewFileAccessKeySource(path)
+	if key, err := source.AccessKey(context.Background()); err != nil || key != "first" {
+		t.Fatalf("expected key first, got %q (%v)", key, err)
+	}
+
+	if err := os.WriteFile(path, []byte("second"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if key, err := source.AccessKey(context.Background()); err != nil || key != "second" {
+		t.Fatalf("expected rotated key second, got %q (%v)", key, err)
+	}
+}
+
+func TestExecAccessKeySource(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{
+			name:   "plain",
+			output: `{"accessKey": "plain-key"}`,
+			want:   "plain-key",
+		},
+		{
+			name:   "exec credential",
+			output: `{"apiVersion": "client.authentication.k8s.io/v1", "kind": "ExecCredential", "status": {"token": "exec-key"}}`,
+			want:   "exec-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := NewExecAccessKeySource(ExecConfig{
+				Command: "sh",
+				Args:    []string{"-c", `echo "$OUTPUT"`},
+				Env:     map[string]string{"OUTPUT": tt.output},
+			})
+
+			key, err := source.AccessKey(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, key)
+			}
+		})
+	}
+}
+
+type countingAccessKeySource struct {
+	keys  []string
+	calls int
+}
+
+func (s *countingAccessKeySource) AccessKey(ctx context.Context) (string, error) {
+	return s.keys[s.calls], nil
+}
+
+func (s *countingAccessKeySource) Invalidate() {
+	s.calls++
+}
+
+func TestAccessKeyTransportRefreshesRejectedKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer static")
+
+	source := &countingAccessKeySource{keys: []string{"expired", "fresh"}}
+	resp, err := newAccessKeyTransport(http.DefaultTransport, source).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected request to succeed with the fresh key, got status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,154 @@
+package loftclient
+
+import (
+	"net/http"
+
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	"k8s.io/client-go/rest"
+)
+
+const DefaultMaxRetries = 5
+
+// TeamGroupPrefix is prepended to a team name to impersonate the team as a
+// group, which is how Loft represents team membership.
+const TeamGroupPrefix = "loft:team:"
+
+// ImpersonationConfig returns the impersonation settings for the given user,
+// team and groups. The team is impersonated as a group with TeamGroupPrefix.
+func ImpersonationConfig(user, team string, groups []string) rest.ImpersonationConfig {
+	impersonate := rest.ImpersonationConfig{
+		UserName: user,
+	}
+	if team != "" {
+		impersonate.Groups = append(impersonate.Groups, TeamGroupPrefix+team)
+	}
+	impersonate.Groups = append(impersonate.Groups, groups...)
+
+	return impersonate
+}
+
+// Options holds the provider level settings that are applied to every
+// management and cluster client handed out to the resources.
+type Options struct {
+	// MaxRetries is the number of times a request is retried on transient
+	// errors and conflicts before giving up.
+	MaxRetries int
+
+	// LogBodies enables TRACE logging of request and response bodies.
+	LogBodies bool
+
+	// RedactBodies replaces secrets in logged bodies.
+	RedactBodies bool
+
+	// AccessKeySource overrides the static access key of the login, if set.
+	AccessKeySource AccessKeySource
+
+	// Impersonate is sent with every management and cluster request.
+	Impersonate rest.ImpersonationConfig
+}
+
+// Client wraps a loftctl client and decorates the rest configs it creates with
+// the transports configured through Options. It still satisfies client.Client,
+// so resources can keep type asserting the provider meta to client.Client.
+type Client struct {
+	client.Client
+
+	options Options
+}
+
+func New(loftClient client.Client, options Options) *Client {
+	return &Client{
+		Client:  loftClient,
+		options: options,
+	}
+}
+
+// FromMeta returns the wrapped client stored in the provider meta, if any.
+// The meta may also be a struct embedding the client.
+func FromMeta(meta interface{}) (*Client, bool) {
+	m, ok := meta.(interface{ LoftClient() *Client })
+	if !ok {
+		return nil, false
+	}
+
+	return m.LoftClient(), true
+}
+
+// LoftClient returns c, it is promoted to the types embedding the client.
+func (c *Client) LoftClient() *Client {
+	return c
+}
+
+func (c *Client) MaxRetries() int {
+	return c.options.MaxRetries
+}
+
+func (c *Client) ManagementConfig() (*rest.Config, error) {
+	return c.wrapConfig(c.Client.ManagementConfig())
+}
+
+func (c *Client) Management() (kube.Interface, error) {
+	return newForConfig(c.ManagementConfig())
+}
+
+func (c *Client) SpaceInstanceConfig(project, name string) (*rest.Config, error) {
+	return c.wrapConfig(c.Client.SpaceInstanceConfig(project, name))
+}
+
+func (c *Client) SpaceInstance(project, name string) (kube.Interface, error) {
+	return newForConfig(c.SpaceInstanceConfig(project, name))
+}
+
+func (c *Client) VirtualClusterInstanceConfig(project, name string) (*rest.Config, error) {
+	return c.wrapConfig(c.Client.VirtualClusterInstanceConfig(project, name))
+}
+
+func (c *Client) VirtualClusterInstance(project, name string) (kube.Interface, error) {
+	return newForConfig(c.VirtualClusterInstanceConfig(project, name))
+}
+
+func (c *Client) ClusterConfig(cluster string) (*rest.Config, error) {
+	return c.wrapConfig(c.Client.ClusterConfig(cluster))
+}
+
+func (c *Client) Cluster(cluster string) (kube.Interface, error) {
+	return newForConfig(c.ClusterConfig(cluster))
+}
+
+func (c *Client) VirtualClusterConfig(cluster, namespace, virtualCluster string) (*rest.Config, error) {
+	return c.wrapConfig(c.Client.VirtualClusterConfig(cluster, namespace, virtualCluster))
+}
+
+func (c *Client) VirtualCluster(cluster, namespace, virtualCluster string) (kube.Interface, error) {
+	return newForConfig(c.VirtualClusterConfig(cluster, namespace, virtualCluster))
+}
+
+func (c *Client) wrapConfig(config *rest.Config, err error) (*rest.Config, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	config.Impersonate = c.options.Impersonate
+	if c.options.AccessKeySource != nil {
+		config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return newAccessKeyTransport(rt, c.options.AccessKeySource)
+		})
+	}
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return newLoggingTransport(rt, c.options.LogBodies, c.options.RedactBodies)
+	})
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return newRetryTransport(rt, c.options.MaxRetries)
+	})
+
+	return config, nil
+}
+
+func newForConfig(config *rest.Config, err error) (kube.Interface, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return kube.NewForConfig(config)
+}
@@ -0,0 +1,91 @@
+package loftclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestImpersonationConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		user   string
+		team   string
+		groups []string
+		want   rest.ImpersonationConfig
+	}{
+		{
+			name: "nothing",
+			want: rest.ImpersonationConfig{},
+		},
+		{
+			name: "user only",
+			user: "alice",
+			want: rest.ImpersonationConfig{UserName: "alice"},
+		},
+		{
+			name:   "team and groups",
+			user:   "alice",
+			team:   "devs",
+			groups: []string{"ops"},
+			want:   rest.ImpersonationConfig{UserName: "alice", Groups: []string{"loft:team:devs", "ops"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ImpersonationConfig(tt.user, tt.team, tt.groups)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ImpersonationConfig() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImpersonationHeaders(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	c := New(nil, Options{
+		Impersonate: ImpersonationConfig("alice", "devs", []string{"ops"}),
+	})
+	config, err := c.wrapConfig(&rest.Config{Host: server.URL}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got := header.Get("Impersonate-User"); got != "alice" {
+		t.Errorf("Impersonate-User = %q, want %q", got, "alice")
+	}
+	if got, want := header.Values("Impersonate-Group"), []string{"loft:team:devs", "ops"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Impersonate-Group = %q, want %q", got, want)
+	}
+}
+
+func TestFromMeta(t *testing.T) {
+	c := New(nil, Options{MaxRetries: 3})
+
+	embedded := struct{ *Client }{Client: c}
+	if got, ok := FromMeta(embedded); !ok || got != c {
+		t.Errorf("FromMeta() of an embedding struct = %v, %v, want the client", got, ok)
+	}
+	if _, ok := FromMeta("not a client"); ok {
+		t.Errorf("FromMeta() of a string succeeded")
+	}
+}
@@ -0,0 +1,148 @@
+package loftclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+)
+
+// Context is a named login listed next to the default login in the Loft
+// config file. loftctl keeps a single login per config file, so a context
+// either points to another config file written by
+// `loft login --config <path>`, or holds the login inline:
+//
+//	{
+//	  "host": "https://loft.example.com",
+//	  "accesskey": "...",
+//	  "contexts": {
+//	    "eu": {"config": "~/.loft/eu.json"},
+//	    "us": {"host": "https://loft-us.example.com", "accesskey": "..."}
+//	  }
+//	}
+//
+// The `contexts` key is ignored by loftctl, it has to be added by hand.
+type Context struct {
+	Config    string `json:"config,omitempty"`
+	Host      string `json:"host,omitempty"`
+	Insecure  bool   `json:"insecure,omitempty"`
+	AccessKey string `json:"accesskey,omitempty"`
+}
+
+type contextsConfig struct {
+	Contexts map[string]json.RawMessage `json:"contexts,omitempty"`
+}
+
+// LoadContext reads and validates the named context from the Loft config file
+// at path. A relative `config` of the context is resolved against the
+// directory of path.
+func LoadContext(path, name string) (*Context, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read loft config %s: %w", path, err)
+	}
+
+	config := &contextsConfig{}
+	if err := json.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("parse loft config %s: %w", path, err)
+	}
+
+	rawContext, ok := config.Contexts[name]
+	if !ok {
+		names := make([]string, 0, len(config.Contexts))
+		for n := range config.Contexts {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		if len(names) == 0 {
+			return nil, fmt.Errorf("context %q not found in %s: the config file does not define any contexts", name, path)
+		}
+		return nil, fmt.Errorf("context %q not found in %s, available contexts are: %s", name, path, strings.Join(names, ", "))
+	}
+
+	loftContext := &Context{}
+	decoder := json.NewDecoder(bytes.NewReader(rawContext))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(loftContext); err != nil {
+		return nil, fmt.Errorf("context %q in %s: %w", name, path, err)
+	}
+	if err := loftContext.validate(); err != nil {
+		return nil, fmt.Errorf("context %q in %s %w", name, path, err)
+	}
+
+	if loftContext.Config != "" {
+		loftContext.Config = resolveConfigPath(filepath.Dir(path), loftContext.Config)
+	}
+
+	return loftContext, nil
+}
+
+func (c *Context) validate() error {
+	if c.Config != "" {
+		if c.Host != "" || c.AccessKey != "" || c.Insecure {
+			return fmt.Errorf("must set either config or host and accesskey")
+		}
+		return nil
+	}
+
+	if c.Host == "" || c.AccessKey == "" {
+		return fmt.Errorf("must set either config or both host and accesskey")
+	}
+
+	host, err := url.Parse(c.Host)
+	if err != nil || (host.Scheme != "https" && host.Scheme != "http") || host.Host == "" {
+		return fmt.Errorf("has an invalid host %q, expected an URL like https://loft.example.com", c.Host)
+	}
+
+	return nil
+}
+
+func resolveConfigPath(dir, path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	return path
+}
+
+// NewClientFromContext creates a client logged into the named context of the
+// Loft config file at path. The config files are never written back.
+func NewClientFromContext(path, name string) (client.Client, error) {
+	loftContext, err := LoadContext(path, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if loftContext.Config != "" {
+		config, err := client.NewClientFromPath(loftContext.Config)
+		if err != nil {
+			return nil, fmt.Errorf("context %q: %w", name, err)
+		}
+
+		loftContext.Host = config.Config().Host
+		loftContext.AccessKey = config.Config().AccessKey
+		loftContext.Insecure = config.Config().Insecure
+		if loftContext.Host == "" || loftContext.AccessKey == "" {
+			return nil, fmt.Errorf("context %q: %s is not logged in, run `loft login --config %s`", name, loftContext.Config, loftContext.Config)
+		}
+	}
+
+	loftClient := client.NewClient()
+	if err := loftClient.LoginRaw(loftContext.Host, loftContext.AccessKey, loftContext.Insecure); err != nil {
+		return nil, err
+	}
+
+	return loftClient, nil
+}
@@ -0,0 +1,86 @@
+package loftclient
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadContext(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	err := os.WriteFile(path, []byte(`{
+  "host": "https://loft.example.com",
+  "accesskey": "default",
+  "contexts": {
+    "eu": {"config": "eu.json"},
+    "us": {"host": "https://loft-us.example.com", "accesskey": "us-key", "insecure": true},
+    "broken": {"host": "https://loft-broken.example.com"},
+    "typo": {"host": "https://loft-typo.example.com", "access_key": "key"},
+    "nohost": {"host": "loft.example.com", "accesskey": "key"},
+    "both": {"config": "eu.json", "host": "https://loft-eu.example.com"},
+    "loggedout": {"config": "loggedout.json"}
+  }
+}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// written by `loft login --config eu.json`
+	err = os.WriteFile(filepath.Join(dir, "eu.json"), []byte(`{
+  "kind": "Config",
+  "apiVersion": "storage.loft.sh/v1",
+  "host": "https://loft-eu.example.com",
+  "accesskey": "eu-key"
+}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loftContext, err := LoadContext(path, "us")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loftContext.Host != "https://loft-us.example.com" || loftContext.AccessKey != "us-key" || !loftContext.Insecure {
+		t.Errorf("unexpected context %+v", loftContext)
+	}
+
+	loftContext, err = LoadContext(path, "eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loftContext.Config != filepath.Join(dir, "eu.json") {
+		t.Errorf("expected config path to be resolved relative to the config file, got %s", loftContext.Config)
+	}
+
+	loftClient, err := NewClientFromContext(path, "eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config := loftClient.Config(); config.Host != "https://loft-eu.example.com" || config.AccessKey != "eu-key" {
+		t.Errorf("expected client to be logged into the eu context, got %s", config.Host)
+	}
+
+	_, err = NewClientFromContext(path, "loggedout")
+	if err == nil || !strings.Contains(err.Error(), "is not logged in") {
+		t.Errorf("expected error for a config file without login, got %v", err)
+	}
+
+	_, err = LoadContext(path, "asia")
+	if err == nil || !strings.Contains(err.Error(), "available contexts are: both, broken, eu, loggedout, nohost, typo, us") {
+		t.Errorf("expected error listing the available contexts, got %v", err)
+	}
+
+	for name, want := range map[string]string{
+		"broken": "must set either config or both host and accesskey",
+		"typo":   `unknown field "access_key"`,
+		"nohost": "has an invalid host",
+		"both":   "must set either config or host and accesskey",
+	} {
+		_, err = LoadContext(path, name)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q for context %s, got %v", want, name, err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package loftclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedValue = "***REDACTED***"
+
+// sensitiveKeyRegex matches JSON keys whose values are replaced before request
+// and response bodies are written to the log.
+var sensitiveKeyRegex = regexp.MustCompile(`(?i)(token|key|password|secret|credential|certificate|kubeconfig)`)
+
+// loggingTransport writes a structured tflog entry for every Loft API call.
+// It runs inside the retry transport, so every attempt is logged.
+type loggingTransport struct {
+	next       http.RoundTripper
+	logBodies  bool
+	redactBody bool
+}
+
+func newLoggingTransport(next http.RoundTripper, logBodies, redactBody bool) http.RoundTripper {
+	return &loggingTransport{
+		next:       next,
+		logBodies:  logBodies,
+		redactBody: redactBody,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	fields := requestFields(req)
+
+	if t.logBodies {
+		if body := t.requestBody(req); body != "" {
+			tflog.Trace(ctx, "Loft API request body", map[string]interface{}{
+				"http_method": req.Method,
+				"http_url":    req.URL.Path,
+				"http_body":   body,
+			})
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Loft API request failed", fields)
+		return resp, err
+	}
+
+	fields["status_code"] = resp.StatusCode
+	tflog.Debug(ctx, "Loft API request", fields)
+
+	if t.logBodies {
+		if body := t.responseBody(resp); body != "" {
+			tflog.Trace(ctx, "Loft API response body", map[string]interface{}{
+				"http_method": req.Method,
+				"http_url":    req.URL.Path,
+				"status_code": resp.StatusCode,
+				"http_body":   body,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+func (t *loggingTransport) requestBody(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	return t.formatBody(raw)
+}
+
+func (t *loggingTransport) responseBody(resp *http.Response) string {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return ""
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(raw))
+	if err != nil {
+		return ""
+	}
+
+	return t.formatBody(raw)
+}
+
+func (t *loggingTransport) formatBody(raw []byte) string {
+	if !t.redactBody {
+		return string(raw)
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		// only structured bodies can be redacted reliably
+		return redactedValue
+	}
+
+	out, err := json.Marshal(redact(obj))
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(out)
+}
+
+func redact(obj interface{}) interface{} {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if sensitiveKeyRegex.MatchString(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redact(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redact(value)
+		}
+	}
+
+	return obj
+}
+
+// requestFields describes the Kubernetes style request, e.g.
+// /kubernetes/management/apis/management.loft.sh/v1/namespaces/loft-p-demo/spaceinstances/space
+func requestFields(req *http.Request) map[string]interface{} {
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.Path,
+	}
+
+	path := req.URL.Path
+	var segments []string
+	if idx := strings.Index(path, "/apis/"); idx >= 0 {
+		// skip group and version
+		segments = splitPath(path[idx+len("/apis/"):])
+		if len(segments) < 2 {
+			return fields
+		}
+		segments = segments[2:]
+	} else if idx := strings.Index(path, "/api/"); idx >= 0 {
+		// skip version
+		segments = splitPath(path[idx+len("/api/"):])
+		if len(segments) < 1 {
+			return fields
+		}
+		segments = segments[1:]
+	} else {
+		return fields
+	}
+
+	if len(segments) >= 3 && segments[0] == "namespaces" {
+		fields["namespace"] = segments[1]
+		segments = segments[2:]
+	}
+	if len(segments) == 0 {
+		return fields
+	}
+
+	fields["kind"] = segments[0]
+	if len(segments) > 1 {
+		fields["name"] = segments[1]
+	}
+	if len(segments) > 2 {
+		fields["subresource"] = segments[2]
+	}
+	fields["verb"] = requestVerb(req.Method, len(segments) > 1)
+
+	return fields
+}
+
+func requestVerb(method string, hasName bool) string {
+	switch method {
+	case http.MethodGet:
+		if hasName {
+			return "get"
+		}
+		return "list"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if hasName {
+			return "delete"
+		}
+		return "deletecollection"
+	}
+
+	return strings.ToLower(method)
+}
+
+func splitPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
@@ -0,0 +1,77 @@
+package loftclient
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRequestFields(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   map[string]interface{}
+	}{
+		{
+			method: http.MethodPatch,
+			path:   "/kubernetes/management/apis/management.loft.sh/v1/namespaces/loft-p-demo/spaceinstances/my-space",
+			want: map[string]interface{}{
+				"verb":      "patch",
+				"kind":      "spaceinstances",
+				"namespace": "loft-p-demo",
+				"name":      "my-space",
+			},
+		},
+		{
+			method: http.MethodGet,
+			path:   "/kubernetes/cluster/loft-cluster/apis/cluster.loft.sh/v1/spaces",
+			want: map[string]interface{}{
+				"verb": "list",
+				"kind": "spaces",
+			},
+		},
+		{
+			method: http.MethodPost,
+			path:   "/kubernetes/management/apis/management.loft.sh/v1/projects/demo/importspace",
+			want: map[string]interface{}{
+				"verb":        "create",
+				"kind":        "projects",
+				"name":        "demo",
+				"subresource": "importspace",
+			},
+		},
+		{
+			method: http.MethodGet,
+			path:   "/version",
+			want:   map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, "https://loft.example.com"+tt.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fields := requestFields(req)
+		delete(fields, "http_method")
+		delete(fields, "http_url")
+		if !reflect.DeepEqual(fields, tt.want) {
+			t.Errorf("%s %s: expected %v, got %v", tt.method, tt.path, tt.want, fields)
+		}
+	}
+}
+
+func TestFormatBodyRedactsSecrets(t *testing.T) {
+	transport := &loggingTransport{redactBody: true}
+
+	body := transport.formatBody([]byte(`{"spec":{"user":"admin","key":"abc"},"status":{"accessKey":"def","kubeConfig":"ghi"}}`))
+	want := `{"spec":{"key":"***REDACTED***","user":"admin"},"status":{"accessKey":"***REDACTED***","kubeConfig":"***REDACTED***"}}`
+	if body != want {
+		t.Errorf("expected %s, got %s", want, body)
+	}
+
+	if body := transport.formatBody([]byte("not json")); body != redactedValue {
+		t.Errorf("expected unstructured body to be redacted, got %s", body)
+	}
+}
@@ -0,0 +1,170 @@
+package loftclient
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+var (
+	// retryBaseDelay is the delay before the first retry, it doubles with
+	// every further attempt up to retryMaxDelay.
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// RetryOnConflict runs fn again whenever it fails with a 409 Conflict. fn must
+// re-read the object it modifies on every call, so the patch is computed
+// against the latest version. The number of attempts follows the provider
+// `max_retries` setting.
+func RetryOnConflict(meta interface{}, fn func() error) error {
+	maxRetries := DefaultMaxRetries
+	if c, ok := FromMeta(meta); ok {
+		maxRetries = c.MaxRetries()
+	}
+
+	return retry.RetryOnConflict(wait.Backoff{
+		Steps:    maxRetries + 1,
+		Duration: 10 * time.Millisecond,
+		Factor:   5.0,
+		Jitter:   0.1,
+		Cap:      retryMaxDelay,
+	}, fn)
+}
+
+// retryTransport retries requests that failed because of rate limiting,
+// unavailable backends or dropped connections, as seen while Loft is upgraded.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int) http.RoundTripper {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		// the body of the original request has been consumed, so it must be
+		// recreated before the request can be sent again
+		retryReq, ok := rewindRequest(req)
+		if !ok {
+			return resp, err
+		}
+
+		delay := retryDelay(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req = retryReq
+	}
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// a refused connection never reached the server, everything else
+		// might have been processed and is only safe to repeat if idempotent
+		if utilnet.IsConnectionRefused(err) {
+			return true
+		}
+
+		return isIdempotent(req.Method) && (utilnet.IsConnectionReset(err) || utilnet.IsProbableEOF(err) || utilnet.IsTimeout(err))
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func rewindRequest(req *http.Request) (*http.Request, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+
+	retryReq := req.Clone(req.Context())
+	retryReq.Body = body
+	return retryReq, true
+}
+
+// retryDelay prefers the Retry-After header sent by the server and falls back
+// to an exponential backoff with jitter.
+func retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	delay := retryBaseDelay << uint(attempt)
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
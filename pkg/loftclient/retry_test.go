@@ -0,0 +1,115 @@
+package loftclient
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	baseDelay := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = baseDelay })
+
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		maxRetries   int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{
+			name:         "retries unavailable until success",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "gives up after max retries",
+			method:       http.MethodPut,
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries:   1,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 2,
+		},
+		{
+			name:         "does not repeat non idempotent requests on server errors",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusInternalServerError,
+			wantAttempts: 1,
+		},
+		{
+			name:         "does not repeat patches on server errors",
+			method:       http.MethodPatch,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 1,
+		},
+		{
+			name:         "does not retry conflicts",
+			method:       http.MethodPut,
+			statuses:     []int{http.StatusConflict, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusConflict,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "payload" {
+					t.Errorf("attempt %d: expected request body to be replayed, got %q", attempt, body)
+				}
+				w.WriteHeader(tt.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(tt.method, server.URL, bytes.NewReader([]byte("payload")))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := newRetryTransport(http.DefaultTransport, tt.maxRetries).RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if delay, ok := parseRetryAfter("3"); !ok || delay != 3*time.Second {
+		t.Errorf("expected 3s, got %s (%t)", delay, ok)
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if delay, ok := parseRetryAfter(date); !ok || delay <= 0 || delay > time.Minute {
+		t.Errorf("expected a delay of up to one minute, got %s (%t)", delay, ok)
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Errorf("expected invalid Retry-After value to be ignored")
+	}
+}
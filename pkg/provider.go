@@ -2,14 +2,19 @@ package loft
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/loft-sh/loftctl/v3/pkg/client"
 	legacy "github.com/loft-sh/terraform-provider-loft/internal/provider"
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
 	"github.com/loft-sh/terraform-provider-loft/pkg/resources"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 )
 
 func init() {
@@ -28,11 +33,17 @@ func New() func() *schema.Provider {
 					Optional:    true,
 					Default:     defaultConfigPath(),
 				},
+				"context": {
+					Description:   "The name of a login listed in the `contexts` map of the Loft config file. A context either references another config file written by `loft login --config <path>` or holds `host`, `accesskey` and `insecure` inline. Allows provider aliases to target different Loft instances from a single config file.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc("LOFT_CONTEXT", nil),
+					ConflictsWith: []string{"host", "access_key"},
+				},
 				"host": {
-					Description:  "The Loft instance host.",
-					Type:         schema.TypeString,
-					Optional:     true,
-					RequiredWith: []string{"access_key"},
+					Description: "The Loft instance host. Required with `access_key`, `access_key_file` or `exec`.",
+					Type:        schema.TypeString,
+					Optional:    true,
 				},
 				"insecure": {
 					Description: "Allow login into an insecure Loft instance. Defaults to `false`.",
@@ -46,22 +57,138 @@ func New() func() *schema.Provider {
 					Optional:     true,
 					RequiredWith: []string{"host"},
 				},
+				"access_key_file": {
+					Description:   "Path to a file containing the Loft access key. The file is read again whenever it changes or Loft rejects the key, so short-lived keys can be rotated while Terraform runs.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					RequiredWith:  []string{"host"},
+					ConflictsWith: []string{"access_key", "exec", "context"},
+				},
+				"exec": {
+					Description:   "Obtain the Loft access key by running a command, similar to kubeconfig exec credential plugins. The command must print a JSON object with an `accessKey` and an optional `expirationTimestamp` in RFC 3339 format, or a Kubernetes `ExecCredential`. The command runs again once the key expires or is rejected by Loft.",
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					RequiredWith:  []string{"host"},
+					ConflictsWith: []string{"access_key", "access_key_file", "context"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Description: "The command to run.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"args": {
+								Description: "The arguments passed to the command.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"env": {
+								Description: "Environment variables set in addition to the environment of Terraform.",
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"impersonate_user": {
+					Description: "Send all Loft API requests as this user, so that permission errors surface as they would for the user. The access key must be allowed to impersonate the user.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"impersonate_team": {
+					Description:  "Impersonate membership of this team in addition to `impersonate_user`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{"impersonate_user"},
+				},
+				"impersonate_groups": {
+					Description:  "Impersonate membership of these groups in addition to `impersonate_user`.",
+					Type:         schema.TypeList,
+					Optional:     true,
+					Elem:         &schema.Schema{Type: schema.TypeString},
+					RequiredWith: []string{"impersonate_user"},
+				},
+				"max_retries": {
+					Description:  "The maximum number of times a Loft API request is retried on rate limiting, server errors, dropped connections and update conflicts. Defaults to `5`.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      loftclient.DefaultMaxRetries,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"log_http_bodies": {
+					Description: "Log the request and response bodies of Loft API calls at `TRACE` level. Defaults to `false`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"redact_http_bodies": {
+					Description: "Replace tokens, keys, passwords and other secrets in logged request and response bodies. Defaults to `true`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+				},
+				"default_annotations": {
+					Description: "Annotations added to every object created by the provider. Annotations set on a resource take precedence. Defaults are not read back, so adding them does not cause a diff on existing resources.",
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"default_labels": {
+					Description: "Labels added to every object created by the provider. Labels set on a resource take precedence. Defaults are not read back, so adding them does not cause a diff on existing resources.",
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"ignore_annotations": {
+					Description: "Regular expressions matching annotation keys that are managed outside of Terraform, e.g. by controllers. Matching annotations are neither read back nor removed unless they are set in the configuration. Annotations owned by Kubernetes or Loft are always ignored.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsValidRegExp,
+					},
+				},
+				"ignore_labels": {
+					Description: "Regular expressions matching label keys that are managed outside of Terraform, e.g. by controllers. Matching labels are neither read back nor removed unless they are set in the configuration. Labels owned by Kubernetes or Loft are always ignored.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsValidRegExp,
+					},
+				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"loft_space":                    legacy.ResourceSpace(),
-				"loft_virtual_cluster":          legacy.ResourceVirtualCluster(),
-				"loft_project":                  resources.ProjectResource(),
-				"loft_space_instance":           resources.SpaceInstanceResource(),
-				"loft_virtual_cluster_instance": resources.VirtualClusterInstanceResource(),
+				"loft_space":                          legacy.ResourceSpace(),
+				"loft_virtual_cluster":                legacy.ResourceVirtualCluster(),
+				"loft_project_import_space":           resources.ProjectImportSpaceResource(),
+				"loft_project_import_virtual_cluster": resources.ProjectImportVirtualClusterResource(),
+				"loft_project_access":                 resources.ProjectAccessResource(),
+				"loft_project_allowed_cluster":        resources.ProjectAllowedClusterResource(),
+				"loft_project_allowed_template":       resources.ProjectAllowedTemplateResource(),
+				"loft_project_member":                 resources.ProjectMemberResource(),
+				"loft_project":                        resources.ProjectResource(),
+				"loft_space_instance":                 resources.SpaceInstanceResource(),
+				"loft_virtual_cluster_instance":       resources.VirtualClusterInstanceResource(),
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"loft_spaces":                   legacy.DataSourceSpaces(),
-				"loft_space":                    legacy.DataSourceSpace(),
-				"loft_virtual_cluster":          legacy.DataSourceVirtualCluster(),
-				"loft_virtual_clusters":         legacy.DataSourceVirtualClusters(),
-				"loft_project":                  resources.ProjectDataSource(),
-				"loft_space_instance":           resources.SpaceInstanceDataSource(),
-				"loft_virtual_cluster_instance": resources.VirtualClusterInstanceDataSource(),
+				"loft_spaces":                    legacy.DataSourceSpaces(),
+				"loft_space":                     legacy.DataSourceSpace(),
+				"loft_virtual_cluster":           legacy.DataSourceVirtualCluster(),
+				"loft_virtual_clusters":          legacy.DataSourceVirtualClusters(),
+				"loft_project":                   resources.ProjectDataSource(),
+				"loft_projects":                  resources.ProjectsDataSource(),
+				"loft_project_clusters":          resources.ProjectClustersDataSource(),
+				"loft_project_members":           resources.ProjectMembersDataSource(),
+				"loft_project_quota_usage":       resources.ProjectQuotaUsageDataSource(),
+				"loft_project_templates":         resources.ProjectTemplatesDataSource(),
+				"loft_space_instance":            resources.SpaceInstanceDataSource(),
+				"loft_space_instances":           resources.SpaceInstancesDataSource(),
+				"loft_virtual_cluster_instance":  resources.VirtualClusterInstanceDataSource(),
+				"loft_virtual_cluster_instances": resources.VirtualClusterInstancesDataSource(),
 			},
 			ConfigureContextFunc: func(c context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 				var (
@@ -70,7 +197,13 @@ func New() func() *schema.Provider {
 				)
 
 				configPath := d.Get("config_path").(string)
-				if configPath != "" {
+				loftContext := d.Get("context").(string)
+				if loftContext != "" {
+					loftClient, err = loftclient.NewClientFromContext(configPath, loftContext)
+					if err != nil {
+						return nil, diag.FromErr(err)
+					}
+				} else if configPath != "" {
 					loftClient, err = client.NewClientFromPath(configPath)
 					if err != nil {
 						return nil, diag.FromErr(err)
@@ -79,18 +212,89 @@ func New() func() *schema.Provider {
 					loftClient = client.NewClient()
 				}
 
-				// Login if access key is provided
+				var accessKeySource loftclient.AccessKeySource
+				if accessKeyFile := d.Get("access_key_file").(string); accessKeyFile != "" {
+					accessKeySource = loftclient.NewFileAccessKeySource(accessKeyFile)
+				} else if execConfig, ok := expandExecConfig(d.Get("exec").([]interface{})); ok {
+					accessKeySource = loftclient.NewExecAccessKeySource(execConfig)
+				}
+
+				host := d.Get("host").(string)
+				insecure := d.Get("insecure").(bool)
 				accessKey := d.Get("access_key").(string)
+				if host != "" && accessKey == "" && accessKeySource == nil {
+					return nil, diag.Errorf("host requires one of access_key, access_key_file or exec to be set")
+				}
+
+				// Login if access key is provided
 				if accessKey != "" {
-					host := d.Get("host").(string)
-					insecure := d.Get("insecure").(bool)
 					err := loftClient.LoginWithAccessKey(host, accessKey, insecure)
 					if err != nil {
 						return nil, diag.FromErr(err)
 					}
+				} else if accessKeySource != nil {
+					// Short-lived keys are never written to the config file, the
+					// access key source is consulted again for every request
+					accessKey, err := accessKeySource.AccessKey(c)
+					if err != nil {
+						return nil, diag.FromErr(err)
+					}
+
+					loftClient = client.NewClient()
+					err = loftClient.LoginRaw(host, accessKey, insecure)
+					if err != nil {
+						return nil, diag.FromErr(err)
+					}
 				}
 
-				return loftClient, nil
+				// Fail early instead of on the first resource if the instance is unreachable
+				if host := loftClient.Config().Host; host != "" {
+					if _, err := loftClient.Version(); err != nil {
+						summary := "Loft instance is not reachable"
+						if loftContext != "" {
+							summary = fmt.Sprintf("Loft instance of context %q is not reachable", loftContext)
+						}
+
+						return nil, diag.Diagnostics{
+							{
+								Severity: diag.Error,
+								Summary:  summary,
+								Detail:   fmt.Sprintf("Could not connect to %s: %s", host, err),
+							},
+						}
+					}
+				}
+
+				ignoreAnnotations, err := compilePatterns(d.Get("ignore_annotations").([]interface{}))
+				if err != nil {
+					return nil, diag.Errorf("invalid `ignore_annotations`: %v", err)
+				}
+				ignoreLabels, err := compilePatterns(d.Get("ignore_labels").([]interface{}))
+				if err != nil {
+					return nil, diag.Errorf("invalid `ignore_labels`: %v", err)
+				}
+
+				var impersonateGroups []string
+				for _, group := range d.Get("impersonate_groups").([]interface{}) {
+					impersonateGroups = append(impersonateGroups, group.(string))
+				}
+				impersonate := loftclient.ImpersonationConfig(d.Get("impersonate_user").(string), d.Get("impersonate_team").(string), impersonateGroups)
+
+				return &utils.ProviderMeta{
+					Client: loftclient.New(loftClient, loftclient.Options{
+						MaxRetries:      d.Get("max_retries").(int),
+						LogBodies:       d.Get("log_http_bodies").(bool),
+						RedactBodies:    d.Get("redact_http_bodies").(bool),
+						AccessKeySource: accessKeySource,
+						Impersonate:     impersonate,
+					}),
+					Metadata: utils.MetadataOptions{
+						IgnoreAnnotations:  ignoreAnnotations,
+						IgnoreLabels:       ignoreLabels,
+						DefaultAnnotations: utils.AttributesToMap(d.Get("default_annotations").(map[string]interface{})),
+						DefaultLabels:      utils.AttributesToMap(d.Get("default_labels").(map[string]interface{})),
+					},
+				}, nil
 			},
 		}
 	}
@@ -104,3 +308,36 @@ func defaultConfigPath() string {
 
 	return filepath.Join(homeDir, ".loft", "config.json")
 }
+
+func expandExecConfig(in []interface{}) (loftclient.ExecConfig, bool) {
+	if len(in) == 0 || in[0] == nil {
+		return loftclient.ExecConfig{}, false
+	}
+
+	data := in[0].(map[string]interface{})
+	execConfig := loftclient.ExecConfig{
+		Command: data["command"].(string),
+		Env:     map[string]string{},
+	}
+	for _, arg := range data["args"].([]interface{}) {
+		execConfig.Args = append(execConfig.Args, arg.(string))
+	}
+	for k, v := range data["env"].(map[string]interface{}) {
+		execConfig.Env[k] = v.(string)
+	}
+
+	return execConfig, true
+}
+
+func compilePatterns(in []interface{}) ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, 0, len(in))
+	for _, raw := range in {
+		pattern, err := regexp.Compile(raw.(string))
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
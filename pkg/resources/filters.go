@@ -0,0 +1,35 @@
+package resources
+
+import (
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+)
+
+// matchesOwner reports whether the object is owned by the user or team, an
+// empty user and team match every object.
+func matchesOwner(owner *storagev1.UserOrTeam, user, team string) bool {
+	if user == "" && team == "" {
+		return true
+	}
+	if owner == nil {
+		return false
+	}
+
+	if user != "" && owner.User != user {
+		return false
+	}
+	if team != "" && owner.Team != team {
+		return false
+	}
+
+	return true
+}
+
+// matchesTemplate reports whether the instance references the template with
+// the name, an empty name matches every instance.
+func matchesTemplate(templateRef *storagev1.TemplateRef, name string) bool {
+	if name == "" {
+		return true
+	}
+
+	return templateRef != nil && templateRef.Name == name
+}
@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/loftctl/v3/pkg/client/naming"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// spaceInstanceImportState imports a space instance by its `<namespace>/<name>`
+// ID. It also accepts `<project>/<cluster>/<space>`, the ID of a legacy
+// loft_space prefixed with a project, which is resolved to the space instance
+// of the project referencing that space, so existing loft_space state can be
+// moved to loft_space_instance. Importing never modifies Loft, the space has to
+// be imported into the project with loft_project_import_space beforehand.
+func spaceInstanceImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tokens := strings.Split(d.Id(), "/")
+	if len(tokens) != 3 {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	managementClient, err := importManagementClient(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	project, cluster, space := tokens[0], tokens[1], tokens[2]
+	namespace := naming.ProjectNamespace(project)
+	instances, err := managementClient.Loft().ManagementV1().SpaceInstances(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, instance := range instances.Items {
+		if instance.Spec.ClusterRef.Cluster == cluster && instance.Spec.ClusterRef.Namespace == space {
+			ids = append(ids, namespace+"/"+instance.Name)
+		}
+	}
+	id, err := legacyImportID(ids, fmt.Sprintf("space %s of cluster %s", space, cluster), project, "loft_project_import_space")
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// virtualClusterInstanceImportState imports a virtual cluster instance by its
+// `<namespace>/<name>` ID. It also accepts
+// `<project>/<cluster>/<namespace>/<virtual cluster>`, the ID of a legacy
+// loft_virtual_cluster prefixed with a project, which is resolved to the
+// virtual cluster instance of the project referencing that virtual cluster.
+// The virtual cluster has to be imported into the project with
+// loft_project_import_virtual_cluster beforehand.
+func virtualClusterInstanceImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tokens := strings.Split(d.Id(), "/")
+	if len(tokens) != 4 {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	managementClient, err := importManagementClient(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	project, cluster, virtualClusterNamespace, virtualCluster := tokens[0], tokens[1], tokens[2], tokens[3]
+	namespace := naming.ProjectNamespace(project)
+	instances, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, instance := range instances.Items {
+		clusterRef := instance.Spec.ClusterRef
+		if clusterRef.Cluster == cluster && clusterRef.Namespace == virtualClusterNamespace && clusterRef.VirtualCluster == virtualCluster {
+			ids = append(ids, namespace+"/"+instance.Name)
+		}
+	}
+	id, err := legacyImportID(ids, fmt.Sprintf("virtual cluster %s/%s of cluster %s", virtualClusterNamespace, virtualCluster, cluster), project, "loft_project_import_virtual_cluster")
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// legacyImportID returns the single instance ID found for a legacy object.
+func legacyImportID(ids []string, object, project, importResource string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%s has not been imported into project %s, import it with a %s resource first", object, project, importResource)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%s is referenced by multiple instances of project %s: %s, import one of them by its ID instead", object, project, strings.Join(ids, ", "))
+	}
+}
+
+func importManagementClient(meta interface{}) (kube.Interface, error) {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("could not access loft client")
+	}
+
+	return loftClient.Management()
+}
+
+// importSpace imports a space into the project and returns the ID of the
+// resulting space instance. A space that was imported under the same name
+// before is not imported again, so an interrupted import can simply be
+// repeated, but an existing instance of another space is never adopted.
+func importSpace(ctx context.Context, managementClient kube.Interface, project, cluster, space, importName string) (string, error) {
+	if project == "" || cluster == "" || space == "" {
+		return "", fmt.Errorf("project, cluster and space are required to import a space")
+	}
+
+	name := importName
+	if name == "" {
+		name = space
+	}
+	namespace := naming.ProjectNamespace(project)
+
+	instance, err := managementClient.Loft().ManagementV1().SpaceInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		if instance.Spec.ClusterRef.Cluster != cluster || instance.Spec.ClusterRef.Namespace != space {
+			return "", fmt.Errorf("space instance %s/%s already exists for another space, set import_name to import space %s under a different name", namespace, name, space)
+		}
+		return namespace + "/" + name, nil
+	} else if !kerrors.IsNotFound(err) {
+		return "", err
+	}
+
+	_, err = managementClient.Loft().ManagementV1().Projects().ImportSpace(ctx, project, &managementv1.ProjectImportSpace{
+		SourceSpace: managementv1.ProjectImportSpaceSource{
+			Name:       space,
+			Cluster:    cluster,
+			ImportName: importName,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("import space %s of cluster %s into project %s: %w", space, cluster, project, err)
+	}
+
+	return namespace + "/" + name, nil
+}
+
+// importVirtualCluster imports a virtual cluster into the project and returns
+// the ID of the resulting virtual cluster instance, see importSpace.
+func importVirtualCluster(ctx context.Context, managementClient kube.Interface, project, cluster, virtualClusterNamespace, virtualCluster, importName string) (string, error) {
+	if project == "" || cluster == "" || virtualClusterNamespace == "" || virtualCluster == "" {
+		return "", fmt.Errorf("project, cluster, namespace and virtual cluster are required to import a virtual cluster")
+	}
+
+	name := importName
+	if name == "" {
+		name = virtualCluster
+	}
+	namespace := naming.ProjectNamespace(project)
+
+	instance, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		clusterRef := instance.Spec.ClusterRef
+		if clusterRef.Cluster != cluster || clusterRef.Namespace != virtualClusterNamespace || clusterRef.VirtualCluster != virtualCluster {
+			return "", fmt.Errorf("virtual cluster instance %s/%s already exists for another virtual cluster, set import_name to import virtual cluster %s/%s under a different name", namespace, name, virtualClusterNamespace, virtualCluster)
+		}
+		return namespace + "/" + name, nil
+	} else if !kerrors.IsNotFound(err) {
+		return "", err
+	}
+
+	_, err = managementClient.Loft().ManagementV1().Projects().ImportVirtualCluster(ctx, project, &managementv1.ProjectImportVirtualCluster{
+		SourceVirtualCluster: managementv1.ProjectImportVirtualClusterSource{
+			Name:       virtualCluster,
+			Namespace:  virtualClusterNamespace,
+			Cluster:    cluster,
+			ImportName: importName,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("import virtual cluster %s/%s of cluster %s into project %s: %w", virtualClusterNamespace, virtualCluster, cluster, project, err)
+	}
+
+	return namespace + "/" + name, nil
+}
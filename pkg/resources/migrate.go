@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client/naming"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// migrateSpaceInstance moves the space instance with the given `<namespace>/<name>`
+// ID into the project of the target namespace without recreating the space.
+func migrateSpaceInstance(ctx context.Context, managementClient kube.Interface, id, targetNamespace string) error {
+	namespace, name := utils.ParseID(id)
+	project, err := projectFromNamespace(targetNamespace)
+	if err != nil {
+		return err
+	}
+
+	_, err = managementClient.Loft().ManagementV1().Projects().MigrateSpaceInstance(ctx, project, &managementv1.ProjectMigrateSpaceInstance{
+		SourceSpaceInstance: managementv1.ProjectMigrateSpaceInstanceSource{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}, metav1.CreateOptions{})
+	return err
+}
+
+// migrateVirtualClusterInstance moves the virtual cluster instance with the
+// given `<namespace>/<name>` ID into the project of the target namespace
+// without recreating the virtual cluster.
+func migrateVirtualClusterInstance(ctx context.Context, managementClient kube.Interface, id, targetNamespace string) error {
+	namespace, name := utils.ParseID(id)
+	project, err := projectFromNamespace(targetNamespace)
+	if err != nil {
+		return err
+	}
+
+	_, err = managementClient.Loft().ManagementV1().Projects().MigrateVirtualClusterInstance(ctx, project, &managementv1.ProjectMigrateVirtualClusterInstance{
+		SourceVirtualClusterInstance: managementv1.ProjectMigrateVirtualClusterInstanceSource{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}, metav1.CreateOptions{})
+	return err
+}
+
+func projectFromNamespace(namespace string) (string, error) {
+	prefix := naming.ProjectNamespace("")
+	if !strings.HasPrefix(namespace, prefix) || namespace == prefix {
+		return "", fmt.Errorf("namespace %q is not a project namespace, expected %s<project>", namespace, prefix)
+	}
+
+	return strings.TrimPrefix(namespace, prefix), nil
+}
@@ -0,0 +1,162 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// templateParametersFunc returns the parameter definitions of the named
+// template and the versions it provides.
+type templateParametersFunc func(ctx context.Context, managementClient kube.Interface, name string) ([]storagev1.AppParameter, map[string][]storagev1.AppParameter, error)
+
+func validateSpaceInstanceParameters(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateTemplateParameters(ctx, d, meta, "space template", func(ctx context.Context, managementClient kube.Interface, name string) ([]storagev1.AppParameter, map[string][]storagev1.AppParameter, error) {
+		template, err := managementClient.Loft().ManagementV1().SpaceTemplates().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		versions := map[string][]storagev1.AppParameter{}
+		for _, version := range template.Spec.Versions {
+			versions[version.Version] = version.Parameters
+		}
+		return template.Spec.Parameters, versions, nil
+	})
+}
+
+func validateVirtualClusterInstanceParameters(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateTemplateParameters(ctx, d, meta, "virtual cluster template", func(ctx context.Context, managementClient kube.Interface, name string) ([]storagev1.AppParameter, map[string][]storagev1.AppParameter, error) {
+		template, err := managementClient.Loft().ManagementV1().VirtualClusterTemplates().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		versions := map[string][]storagev1.AppParameter{}
+		for _, version := range template.Spec.Versions {
+			versions[version.Version] = version.Parameters
+		}
+		return template.Spec.Parameters, versions, nil
+	})
+}
+
+// validateTemplateParameters resolves the template referenced by
+// `spec.0.template_ref` and validates `spec.0.parameters` or
+// `spec.0.parameters_map` against its parameter definitions. The check is
+// skipped whenever the template cannot be resolved at plan time, e.g. because
+// it is created in the same apply.
+func validateTemplateParameters(ctx context.Context, d *schema.ResourceDiff, meta interface{}, kind string, getTemplate templateParametersFunc) error {
+	if !d.NewValueKnown("spec.0.template_ref") || !d.NewValueKnown("spec.0.parameters") || !d.NewValueKnown("spec.0.parameters_map") {
+		return nil
+	}
+
+	parameters, _ := d.Get("spec.0.parameters").(string)
+	if utils.IsConfigured(d.GetRawConfig(), "spec.0.parameters_map") {
+		var err error
+		parameters, err = utils.ParametersFromMap(d.Get("spec.0.parameters_map").(map[string]interface{}))
+		if err != nil {
+			return fmt.Errorf("invalid `spec.0.parameters_map`: %w", err)
+		}
+	}
+
+	name, _ := d.Get("spec.0.template_ref.0.name").(string)
+	if name == "" {
+		return nil
+	}
+	version, _ := d.Get("spec.0.template_ref.0.version").(string)
+
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return nil
+	}
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return nil
+	}
+
+	definitions, versions, err := getTemplate(ctx, managementClient, name)
+	if err != nil {
+		return nil
+	}
+
+	if len(versions) > 0 {
+		available := make([]string, 0, len(versions))
+		for v := range versions {
+			available = append(available, v)
+		}
+
+		resolved, ok := utils.ResolveTemplateVersion(version, available)
+		if !ok {
+			return fmt.Errorf("%s %q has no version matching %q", kind, name, version)
+		}
+		definitions = versions[resolved]
+	}
+
+	errs := utils.ValidateParameters(definitions, parameters)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, "  - "+err.Error())
+	}
+	return fmt.Errorf("invalid `spec.0.parameters` for %s %q:\n%s", kind, name, strings.Join(messages, "\n"))
+}
+
+// readParametersMap sets `parameters_map` from the parameters stored by Loft.
+// The configured map is kept as long as it still describes these parameters,
+// so formatting differences do not cause a diff.
+func readParametersMap(d *schema.ResourceData, spec interface{}, parameters string) error {
+	values, ok := spec.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if configured, ok := d.GetOk("spec.0.parameters_map"); ok {
+		if current, err := utils.ParametersFromMap(configured.(map[string]interface{})); err == nil && utils.EquivalentYAML(current, parameters) {
+			values["parameters_map"] = configured
+			return nil
+		}
+	}
+
+	parametersMap, err := utils.ParametersToMap(parameters)
+	if err != nil {
+		return err
+	}
+
+	values["parameters_map"] = parametersMap
+	return nil
+}
+
+// instanceSpecData returns the spec data to create the instance from. Both
+// `parameters` and `parameters_map` are computed from each other, so only the
+// one that is configured is kept and `parameters_map` is converted into
+// `parameters`.
+func instanceSpecData(d *schema.ResourceData, data map[string]interface{}) (map[string]interface{}, error) {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return data, nil
+	}
+
+	if utils.IsConfigured(config, "spec.0.parameters_map") {
+		parametersMap, _ := data["parameters_map"].(map[string]interface{})
+		parameters, err := utils.ParametersFromMap(parametersMap)
+		if err != nil {
+			return nil, fmt.Errorf("invalid `spec.0.parameters_map`: %w", err)
+		}
+		data["parameters"] = parameters
+	} else if !utils.IsConfigured(config, "spec.0.parameters") {
+		delete(data, "parameters")
+	}
+	delete(data, "parameters_map")
+
+	return data, nil
+}
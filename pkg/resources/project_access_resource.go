@@ -0,0 +1,355 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/loftctl/v3/pkg/client/naming"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
+	"github.com/loft-sh/terraform-provider-loft/pkg/schemas"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	accessTargetSpaceInstance          = "space_instance"
+	accessTargetVirtualClusterInstance = "virtual_cluster_instance"
+)
+
+// accessRules are the access rules of a project or instance, which can also be
+// managed by `loft_project_access` resources.
+var accessRules = sharedList{
+	attribute:  "access",
+	annotation: "terraform.loft.sh/authoritative-access",
+	resource:   "loft_project_access",
+}
+
+func ProjectAccessResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "ProjectAccess manages a single named access rule of a project, space instance or virtual cluster instance without touching its other access rules. It cannot be used with objects that configure `access` on their own resource.",
+		Schema:        projectAccessAttributes(),
+		CreateContext: projectAccessCreate,
+		ReadContext:   projectAccessRead,
+		UpdateContext: projectAccessUpdate,
+		DeleteContext: projectAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: projectAccessImport,
+		},
+	}
+}
+
+func projectAccessAttributes() map[string]*schema.Schema {
+	attributes := schemas.StorageV1AccessSchema()
+	attributes["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the access rule, must be unique within the access rules of the object.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	attributes["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Unique identifier for this resource. The format is `<project>/<name>` for project access rules and `<project>/space_instance/<instance>/<name>` or `<project>/virtual_cluster_instance/<instance>/<name>` for instance access rules.",
+	}
+	attributes["project"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the project.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	attributes[accessTargetSpaceInstance] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "The name of a space instance in the project. If set, the access rule is added to the space instance instead of the project.",
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{accessTargetVirtualClusterInstance},
+	}
+	attributes[accessTargetVirtualClusterInstance] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "The name of a virtual cluster instance in the project. If set, the access rule is added to the virtual cluster instance instead of the project.",
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{accessTargetSpaceInstance},
+	}
+
+	return attributes
+}
+
+func generateProjectAccessID(d *schema.ResourceData) string {
+	tokens := []string{d.Get("project").(string)}
+	for _, target := range []string{accessTargetSpaceInstance, accessTargetVirtualClusterInstance} {
+		if instance := d.Get(target).(string); instance != "" {
+			tokens = append(tokens, target, instance)
+		}
+	}
+
+	return strings.Join(append(tokens, d.Get("name").(string)), "/")
+}
+
+func projectAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rules, err := getAccessRules(ctx, managementClient, d)
+	if kerrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return utils.APIError(err, "read", "loft_project_access", d.Id())
+	}
+
+	i := findAccessRule(rules, d.Get("name").(string))
+	if i < 0 {
+		// the access rule was removed outside of terraform
+		d.SetId("")
+		return nil
+	}
+
+	rule, err := schemas.ReadStorageV1Access(&rules[i])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, key := range []string{"subresources", "teams", "users", "verbs"} {
+		if err := d.Set(key, rule.(map[string]interface{})[key]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func projectAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := generateProjectAccessID(d)
+	rule := projectAccessRule(d)
+	err = patchAccessRules(ctx, meta, managementClient, d, func(resourceType string, metadata metav1.ObjectMeta, rules []storagev1.Access) ([]storagev1.Access, error) {
+		if err := accessRules.check(metadata, resourceType); err != nil {
+			return nil, err
+		}
+
+		if findAccessRule(rules, rule.Name) >= 0 {
+			return nil, fmt.Errorf("an access rule named %q already exists, import it with the ID %q to manage it", rule.Name, id)
+		}
+
+		return append(rules, rule), nil
+	})
+	if err != nil {
+		return utils.APIError(err, "create", "loft_project_access", id)
+	}
+
+	d.SetId(id)
+
+	return projectAccessRead(ctx, d, meta)
+}
+
+func projectAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := projectAccessRule(d)
+	err = patchAccessRules(ctx, meta, managementClient, d, func(resourceType string, metadata metav1.ObjectMeta, rules []storagev1.Access) ([]storagev1.Access, error) {
+		if err := accessRules.check(metadata, resourceType); err != nil {
+			return nil, err
+		}
+
+		i := findAccessRule(rules, rule.Name)
+		if i < 0 {
+			return nil, fmt.Errorf("access rule %q was removed from %s %q", rule.Name, resourceType, metadata.Name)
+		}
+
+		rules[i] = rule
+		return rules, nil
+	})
+	if err != nil {
+		return utils.APIError(err, "update", "loft_project_access", d.Id())
+	}
+
+	return projectAccessRead(ctx, d, meta)
+}
+
+func projectAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = patchAccessRules(ctx, meta, managementClient, d, func(_ string, _ metav1.ObjectMeta, rules []storagev1.Access) ([]storagev1.Access, error) {
+		if i := findAccessRule(rules, d.Get("name").(string)); i >= 0 {
+			rules = append(rules[:i], rules[i+1:]...)
+		}
+		return rules, nil
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return utils.APIError(err, "delete", "loft_project_access", d.Id())
+	}
+
+	return nil
+}
+
+func projectAccessImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tokens := strings.Split(d.Id(), "/")
+	switch {
+	case len(tokens) == 2:
+	case len(tokens) == 4 && (tokens[1] == accessTargetSpaceInstance || tokens[1] == accessTargetVirtualClusterInstance):
+		if err := d.Set(tokens[1], tokens[2]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unexpected ID %q, expected `<project>/<name>` or `<project>/<space_instance|virtual_cluster_instance>/<instance>/<name>`", d.Id())
+	}
+
+	if err := d.Set("project", tokens[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("name", tokens[len(tokens)-1]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func projectAccessRule(d *schema.ResourceData) storagev1.Access {
+	return *schemas.CreateStorageV1Access(map[string]interface{}{
+		"name":         d.Get("name"),
+		"subresources": d.Get("subresources"),
+		"teams":        d.Get("teams"),
+		"users":        d.Get("users"),
+		"verbs":        d.Get("verbs"),
+	})
+}
+
+func findAccessRule(rules []storagev1.Access, name string) int {
+	for i, rule := range rules {
+		if rule.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// getAccessRules returns the access rules of the project or instance the
+// resource refers to.
+func getAccessRules(ctx context.Context, managementClient kube.Interface, d *schema.ResourceData) ([]storagev1.Access, error) {
+	project := d.Get("project").(string)
+	namespace := naming.ProjectNamespace(project)
+
+	if name := d.Get(accessTargetSpaceInstance).(string); name != "" {
+		instance, err := managementClient.Loft().ManagementV1().SpaceInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return instance.Spec.Access, nil
+	}
+
+	if name := d.Get(accessTargetVirtualClusterInstance).(string); name != "" {
+		instance, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return instance.Spec.Access, nil
+	}
+
+	instance, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return instance.Spec.Access, nil
+}
+
+// patchAccessRules applies mutate to the latest access rules of the project or
+// instance the resource refers to and patches only the access rules. mutate
+// also receives the resource type and metadata of the object.
+func patchAccessRules(ctx context.Context, meta interface{}, managementClient kube.Interface, d *schema.ResourceData, mutate func(resourceType string, metadata metav1.ObjectMeta, rules []storagev1.Access) ([]storagev1.Access, error)) error {
+	project := d.Get("project").(string)
+	namespace := naming.ProjectNamespace(project)
+
+	if name := d.Get(accessTargetSpaceInstance).(string); name != "" {
+		return loftclient.RetryOnConflict(meta, func() error {
+			oldInstance, err := managementClient.Loft().ManagementV1().SpaceInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			modifiedInstance := oldInstance.DeepCopy()
+			if modifiedInstance.Spec.Access, err = mutate("loft_space_instance", modifiedInstance.ObjectMeta, modifiedInstance.Spec.Access); err != nil {
+				return err
+			}
+
+			patch := ctrlclient.MergeFromWithOptions(oldInstance, ctrlclient.MergeFromWithOptimisticLock{})
+			rawPatch, err := patch.Data(modifiedInstance)
+			if err != nil {
+				return err
+			}
+
+			_, err = managementClient.Loft().ManagementV1().SpaceInstances(namespace).Patch(ctx, name, patch.Type(), rawPatch, metav1.PatchOptions{})
+			return err
+		})
+	}
+
+	if name := d.Get(accessTargetVirtualClusterInstance).(string); name != "" {
+		return loftclient.RetryOnConflict(meta, func() error {
+			oldInstance, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			modifiedInstance := oldInstance.DeepCopy()
+			if modifiedInstance.Spec.Access, err = mutate("loft_virtual_cluster_instance", modifiedInstance.ObjectMeta, modifiedInstance.Spec.Access); err != nil {
+				return err
+			}
+
+			patch := ctrlclient.MergeFromWithOptions(oldInstance, ctrlclient.MergeFromWithOptimisticLock{})
+			rawPatch, err := patch.Data(modifiedInstance)
+			if err != nil {
+				return err
+			}
+
+			_, err = managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Patch(ctx, name, patch.Type(), rawPatch, metav1.PatchOptions{})
+			return err
+		})
+	}
+
+	return patchProject(ctx, meta, managementClient, project, func(project *managementv1.Project) error {
+		var err error
+		project.Spec.Access, err = mutate("loft_project", project.ObjectMeta, project.Spec.Access)
+		return err
+	})
+}
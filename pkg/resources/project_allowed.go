@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+)
+
+// projectAllowedClusters and projectAllowedTemplates are the allowed clusters
+// and templates of a project, which can also be managed by
+// `loft_project_allowed_cluster` and `loft_project_allowed_template` resources.
+var (
+	projectAllowedClusters = sharedList{
+		attribute:  "allowed_clusters",
+		annotation: "terraform.loft.sh/authoritative-allowed-clusters",
+		resource:   "loft_project_allowed_cluster",
+	}
+	projectAllowedTemplates = sharedList{
+		attribute:  "allowed_templates",
+		annotation: "terraform.loft.sh/authoritative-allowed-templates",
+		resource:   "loft_project_allowed_template",
+	}
+)
+
+// addProjectAllowed patches the project with add, which returns false if the
+// project already allows the entry. Existing entries are not adopted, they
+// have to be imported with the given ID instead.
+func addProjectAllowed(ctx context.Context, meta interface{}, managementClient kube.Interface, projectName string, list sharedList, entry, id string, add func(spec *managementv1.ProjectSpec) bool) error {
+	return patchProject(ctx, meta, managementClient, projectName, func(project *managementv1.Project) error {
+		if err := list.check(project.ObjectMeta, "loft_project"); err != nil {
+			return err
+		}
+
+		if !add(&project.Spec) {
+			return fmt.Errorf("project %q already allows %s, import it with the ID %q to manage it", projectName, entry, id)
+		}
+
+		return nil
+	})
+}
+
+// removeProjectAllowed patches the project with remove.
+func removeProjectAllowed(ctx context.Context, meta interface{}, managementClient kube.Interface, projectName string, remove func(spec *managementv1.ProjectSpec)) error {
+	return patchProject(ctx, meta, managementClient, projectName, func(project *managementv1.Project) error {
+		remove(&project.Spec)
+		return nil
+	})
+}
+
+func findAllowedCluster(clusters []storagev1.AllowedCluster, name string) int {
+	for i, cluster := range clusters {
+		if cluster.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func findAllowedTemplate(templates []storagev1.AllowedTemplate, kind, group, name string) int {
+	for i, template := range templates {
+		if template.Kind == kind && template.Group == group && template.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
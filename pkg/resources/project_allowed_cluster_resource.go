@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectAllowedClusterResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "ProjectAllowedCluster allows a project to create instances on a cluster without managing the other allowed clusters of the project. It cannot be used with projects that configure `allowed_clusters` on the `loft_project` resource.",
+		Schema:        projectAllowedClusterAttributes(),
+		CreateContext: projectAllowedClusterCreate,
+		ReadContext:   projectAllowedClusterRead,
+		DeleteContext: projectAllowedClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: projectAllowedClusterImport,
+		},
+	}
+}
+
+func projectAllowedClusterAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unique identifier for this resource. The format is `<project>/<cluster>`.",
+		},
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"cluster": {
+			Type:        schema.TypeString,
+			Description: "The name of the cluster the project is allowed to use, or `*` for all clusters.",
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+}
+
+func projectAllowedClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, d.Get("project").(string), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return utils.APIError(err, "read", "loft_project_allowed_cluster", d.Id())
+	}
+
+	if findAllowedCluster(project.Spec.AllowedClusters, d.Get("cluster").(string)) < 0 {
+		// the cluster was removed outside of terraform
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func projectAllowedClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectName := d.Get("project").(string)
+	cluster := d.Get("cluster").(string)
+	id := projectName + "/" + cluster
+
+	err = addProjectAllowed(ctx, meta, managementClient, projectName, projectAllowedClusters, fmt.Sprintf("cluster %q", cluster), id, func(spec *managementv1.ProjectSpec) bool {
+		if findAllowedCluster(spec.AllowedClusters, cluster) >= 0 {
+			return false
+		}
+
+		spec.AllowedClusters = append(spec.AllowedClusters, storagev1.AllowedCluster{Name: cluster})
+		return true
+	})
+	if err != nil {
+		return utils.APIError(err, "create", "loft_project_allowed_cluster", id)
+	}
+
+	d.SetId(id)
+
+	return projectAllowedClusterRead(ctx, d, meta)
+}
+
+func projectAllowedClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = removeProjectAllowed(ctx, meta, managementClient, d.Get("project").(string), func(spec *managementv1.ProjectSpec) {
+		if i := findAllowedCluster(spec.AllowedClusters, d.Get("cluster").(string)); i >= 0 {
+			spec.AllowedClusters = append(spec.AllowedClusters[:i], spec.AllowedClusters[i+1:]...)
+		}
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return utils.APIError(err, "delete", "loft_project_allowed_cluster", d.Id())
+	}
+
+	return nil
+}
+
+func projectAllowedClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	project, cluster := utils.ParseID(d.Id())
+	if project == "" || cluster == "" {
+		return nil, fmt.Errorf("unexpected ID %q, expected `<project>/<cluster>`", d.Id())
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return nil, err
+	}
+	if err := d.Set("cluster", cluster); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
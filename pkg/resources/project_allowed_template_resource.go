@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectAllowedTemplateResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "ProjectAllowedTemplate allows a project to use a space or virtual cluster template without managing the other allowed templates of the project. It cannot be used with projects that configure `allowed_templates` on the `loft_project` resource.",
+		Schema:        projectAllowedTemplateAttributes(),
+		CreateContext: projectAllowedTemplateCreate,
+		ReadContext:   projectAllowedTemplateRead,
+		UpdateContext: projectAllowedTemplateUpdate,
+		DeleteContext: projectAllowedTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: projectAllowedTemplateImport,
+		},
+	}
+}
+
+func projectAllowedTemplateAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unique identifier for this resource. The format is `<project>/<kind>/<name>`.",
+		},
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Type:         schema.TypeString,
+			Description:  "The kind of the template, either `SpaceTemplate` or `VirtualClusterTemplate`.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"SpaceTemplate", "VirtualClusterTemplate"}, false),
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Description: "The group of the template.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     storagev1.GroupVersion.Group,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the template the project is allowed to use, or `*` for all templates of this kind.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"is_default": {
+			Type:        schema.TypeBool,
+			Description: "Use the template by default for new instances of the project.",
+			Optional:    true,
+		},
+	}
+}
+
+func projectAllowedTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, d.Get("project").(string), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return utils.APIError(err, "read", "loft_project_allowed_template", d.Id())
+	}
+
+	i := findAllowedTemplate(project.Spec.AllowedTemplates, d.Get("kind").(string), d.Get("group").(string), d.Get("name").(string))
+	if i < 0 {
+		// the template was removed outside of terraform
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("is_default", project.Spec.AllowedTemplates[i].IsDefault); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func projectAllowedTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectName := d.Get("project").(string)
+	template := storagev1.AllowedTemplate{
+		Kind:      d.Get("kind").(string),
+		Group:     d.Get("group").(string),
+		Name:      d.Get("name").(string),
+		IsDefault: d.Get("is_default").(bool),
+	}
+	id := strings.Join([]string{projectName, template.Kind, template.Name}, "/")
+
+	err = addProjectAllowed(ctx, meta, managementClient, projectName, projectAllowedTemplates, fmt.Sprintf("%s %q", template.Kind, template.Name), id, func(spec *managementv1.ProjectSpec) bool {
+		if findAllowedTemplate(spec.AllowedTemplates, template.Kind, template.Group, template.Name) >= 0 {
+			return false
+		}
+
+		spec.AllowedTemplates = append(spec.AllowedTemplates, template)
+		return true
+	})
+	if err != nil {
+		return utils.APIError(err, "create", "loft_project_allowed_template", id)
+	}
+
+	d.SetId(id)
+
+	return projectAllowedTemplateRead(ctx, d, meta)
+}
+
+func projectAllowedTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = patchProject(ctx, meta, managementClient, d.Get("project").(string), func(project *managementv1.Project) error {
+		i := findAllowedTemplate(project.Spec.AllowedTemplates, d.Get("kind").(string), d.Get("group").(string), d.Get("name").(string))
+		if i < 0 {
+			return fmt.Errorf("template was removed from the project")
+		}
+
+		project.Spec.AllowedTemplates[i].IsDefault = d.Get("is_default").(bool)
+		return nil
+	})
+	if err != nil {
+		return utils.APIError(err, "update", "loft_project_allowed_template", d.Id())
+	}
+
+	return projectAllowedTemplateRead(ctx, d, meta)
+}
+
+func projectAllowedTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = removeProjectAllowed(ctx, meta, managementClient, d.Get("project").(string), func(spec *managementv1.ProjectSpec) {
+		if i := findAllowedTemplate(spec.AllowedTemplates, d.Get("kind").(string), d.Get("group").(string), d.Get("name").(string)); i >= 0 {
+			spec.AllowedTemplates = append(spec.AllowedTemplates[:i], spec.AllowedTemplates[i+1:]...)
+		}
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return utils.APIError(err, "delete", "loft_project_allowed_template", d.Id())
+	}
+
+	return nil
+}
+
+func projectAllowedTemplateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tokens := strings.Split(d.Id(), "/")
+	if len(tokens) != 3 {
+		return nil, fmt.Errorf("unexpected ID %q, expected `<project>/<kind>/<name>`", d.Id())
+	}
+
+	if err := d.Set("project", tokens[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("kind", tokens[1]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("group", storagev1.GroupVersion.Group); err != nil {
+		return nil, err
+	}
+	if err := d.Set("name", tokens[2]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
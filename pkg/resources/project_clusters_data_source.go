@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectClustersDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `loft_project_clusters` data source lists the clusters that instances of the given project may be created on.",
+		Schema:      projectClustersAttributes(),
+		ReadContext: projectClustersRead,
+	}
+}
+
+func projectClustersAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project.",
+			Required:    true,
+		},
+		"clusters": {
+			Type:        schema.TypeList,
+			Description: "The clusters allowed in the project.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the cluster.",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the cluster shown in the UI.",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "The description of the cluster.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project := d.Get("project").(string)
+	projectClusters, err := managementClient.Loft().ManagementV1().Projects().ListClusters(ctx, project, metav1.GetOptions{})
+	if err != nil {
+		return utils.APIError(err, "list clusters of", "loft_project", project)
+	}
+
+	clusters := []interface{}{}
+	for _, cluster := range projectClusters.Clusters {
+		clusters = append(clusters, map[string]interface{}{
+			"name":         cluster.GetName(),
+			"display_name": cluster.Spec.DisplayName,
+			"description":  cluster.Spec.Description,
+		})
+	}
+
+	d.SetId(project)
+	if err := d.Set("clusters", clusters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -37,6 +37,8 @@ func projectDataSourceSchema() map[string]*schema.Schema {
 
 	attributes["spec"].Required = false
 	attributes["spec"].Computed = true
+	attributes["spec"].MaxItems = 0
+	utils.RemoveCrossFieldRules(attributes["spec"].Elem.(*schema.Resource).Schema)
 
 	return attributes
 }
@@ -47,5 +49,5 @@ func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	d.SetId(utils.ReadId(metadata))
 
-	return projectRead(ctx, d, meta)
+	return projectRead(ctx, d, utils.DataSourceMeta(meta))
 }
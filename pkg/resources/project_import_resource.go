@@ -0,0 +1,197 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// projectImport describes an import subresource of a project, which creates
+// an instance in the project for an existing space or virtual cluster.
+type projectImport struct {
+	// resourceType is the name of the import resource, e.g. loft_project_import_space.
+	resourceType string
+	// object is the kind of the imported object, e.g. space.
+	object string
+	// instanceResource is the resource managing the resulting instance.
+	instanceResource string
+	// source holds the attributes identifying the imported object.
+	source map[string]*schema.Schema
+	// sourceID identifies the object configured in d in diagnostics.
+	sourceID func(d *schema.ResourceData) string
+	// importInstance imports the object configured in d and returns the ID
+	// of the resulting instance.
+	importInstance func(ctx context.Context, managementClient kube.Interface, d *schema.ResourceData) (string, error)
+	// getInstance reads the resulting instance.
+	getInstance func(ctx context.Context, managementClient kube.Interface, namespace, name string) error
+}
+
+func ProjectImportSpaceResource() *schema.Resource {
+	return projectImportResource(projectImport{
+		resourceType:     "loft_project_import_space",
+		object:           "space",
+		instanceResource: "loft_space_instance",
+		source: map[string]*schema.Schema{
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the space to import.",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+		sourceID: func(d *schema.ResourceData) string {
+			return d.Get("project").(string) + "/" + d.Get("cluster").(string) + "/" + d.Get("space").(string)
+		},
+		importInstance: func(ctx context.Context, managementClient kube.Interface, d *schema.ResourceData) (string, error) {
+			return importSpace(ctx, managementClient, d.Get("project").(string), d.Get("cluster").(string), d.Get("space").(string), d.Get("import_name").(string))
+		},
+		getInstance: func(ctx context.Context, managementClient kube.Interface, namespace, name string) error {
+			_, err := managementClient.Loft().ManagementV1().SpaceInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+			return err
+		},
+	}, "ProjectImportSpace imports an existing space into a project, which creates a SpaceInstance for it. Destroying this resource does not delete the space instance.")
+}
+
+func ProjectImportVirtualClusterResource() *schema.Resource {
+	return projectImportResource(projectImport{
+		resourceType:     "loft_project_import_virtual_cluster",
+		object:           "virtual cluster",
+		instanceResource: "loft_virtual_cluster_instance",
+		source: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The namespace the virtual cluster is running in.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"virtual_cluster": {
+				Type:        schema.TypeString,
+				Description: "The name of the virtual cluster to import.",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+		sourceID: func(d *schema.ResourceData) string {
+			return d.Get("project").(string) + "/" + d.Get("cluster").(string) + "/" + d.Get("namespace").(string) + "/" + d.Get("virtual_cluster").(string)
+		},
+		importInstance: func(ctx context.Context, managementClient kube.Interface, d *schema.ResourceData) (string, error) {
+			return importVirtualCluster(ctx, managementClient, d.Get("project").(string), d.Get("cluster").(string), d.Get("namespace").(string), d.Get("virtual_cluster").(string), d.Get("import_name").(string))
+		},
+		getInstance: func(ctx context.Context, managementClient kube.Interface, namespace, name string) error {
+			_, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+			return err
+		},
+	}, "ProjectImportVirtualCluster imports an existing virtual cluster into a project, which creates a VirtualClusterInstance for it. Destroying this resource does not delete the virtual cluster instance.")
+}
+
+func projectImportResource(p projectImport, description string) *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      p.attributes(),
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return p.create(ctx, d, meta)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return p.read(ctx, d, meta)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			// The imported instance is intentionally left in place, it is
+			// usually managed by an instance resource afterwards.
+			d.SetId("")
+			return nil
+		},
+	}
+}
+
+func (p projectImport) attributes() map[string]*schema.Schema {
+	attributes := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unique identifier for this resource. The format is `<namespace>/<name>` of the resulting " + p.object + " instance.",
+		},
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project to import the " + p.object + " into.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"cluster": {
+			Type:        schema.TypeString,
+			Description: "The name of the cluster the " + p.object + " is running on.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"import_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the resulting " + p.object + " instance. Defaults to the name of the " + p.object + ". Importing fails if an instance of another " + p.object + " with this name already exists.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the resulting " + p.object + " instance in the format `<namespace>/<name>`, which can be used to import it into a `" + p.instanceResource + "` resource.",
+			Computed:    true,
+		},
+	}
+	for key, attribute := range p.source {
+		attributes[key] = attribute
+	}
+
+	return attributes
+}
+
+func (p projectImport) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name := utils.ParseID(d.Id())
+	err = p.getInstance(ctx, managementClient, namespace, name)
+	if kerrors.IsNotFound(err) {
+		// the instance is gone, importing again recreates it
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return utils.APIError(err, "read", p.instanceResource, d.Id())
+	}
+
+	if err := d.Set("instance_id", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func (p projectImport) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := p.importInstance(ctx, managementClient, d)
+	if err != nil {
+		return utils.APIError(err, "import", p.resourceType, p.sourceID(d))
+	}
+
+	d.SetId(id)
+
+	return p.read(ctx, d, meta)
+}
@@ -0,0 +1,225 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectMemberResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "ProjectMember adds a single user or team to a project without managing its other members. It cannot be used with projects that configure `members` on the `loft_project` resource.",
+		Schema:        projectMemberAttributes(),
+		CreateContext: projectMemberCreate,
+		ReadContext:   projectMemberRead,
+		UpdateContext: projectMemberUpdate,
+		DeleteContext: projectMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: projectMemberImport,
+		},
+	}
+}
+
+func projectMemberAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unique identifier for this resource. The format is `<project>/<kind>/<name>`.",
+		},
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project to add the member to.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Type:         schema.TypeString,
+			Description:  "The kind of the member, either `User` or `Team`.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"User", "Team"}, false),
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Description: "The group of the member.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     storagev1.GroupVersion.Group,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the user or team.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"cluster_role": {
+			Type:        schema.TypeString,
+			Description: "The cluster role assigned to the member, e.g. `loft-management-project-user`.",
+			Required:    true,
+		},
+	}
+}
+
+func generateProjectMemberID(project, kind, name string) string {
+	return strings.Join([]string{project, kind, name}, "/")
+}
+
+func projectMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectName := d.Get("project").(string)
+	project, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, projectName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return utils.APIError(err, "read", "loft_project_member", d.Id())
+	}
+
+	i := findProjectMember(project.Spec.Members, d.Get("kind").(string), d.Get("group").(string), d.Get("name").(string))
+	if i < 0 {
+		// the member was removed outside of terraform
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("cluster_role", project.Spec.Members[i].ClusterRole); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func projectMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectName := d.Get("project").(string)
+	member := storagev1.Member{
+		Kind:        d.Get("kind").(string),
+		Group:       d.Get("group").(string),
+		Name:        d.Get("name").(string),
+		ClusterRole: d.Get("cluster_role").(string),
+	}
+	id := generateProjectMemberID(projectName, member.Kind, member.Name)
+
+	err = patchProject(ctx, meta, managementClient, projectName, func(project *managementv1.Project) error {
+		if err := projectMembers.check(project.ObjectMeta, "loft_project"); err != nil {
+			return err
+		}
+
+		if i := findProjectMember(project.Spec.Members, member.Kind, member.Group, member.Name); i >= 0 {
+			return fmt.Errorf("%s %q is already a member of project %q, import it with the ID %q to manage it", member.Kind, member.Name, projectName, id)
+		}
+
+		project.Spec.Members = append(project.Spec.Members, member)
+		return nil
+	})
+	if err != nil {
+		return utils.APIError(err, "create", "loft_project_member", id)
+	}
+
+	d.SetId(id)
+
+	return projectMemberRead(ctx, d, meta)
+}
+
+func projectMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = patchProject(ctx, meta, managementClient, d.Get("project").(string), func(project *managementv1.Project) error {
+		i := findProjectMember(project.Spec.Members, d.Get("kind").(string), d.Get("group").(string), d.Get("name").(string))
+		if i < 0 {
+			return fmt.Errorf("member was removed from the project")
+		}
+
+		project.Spec.Members[i].ClusterRole = d.Get("cluster_role").(string)
+		return nil
+	})
+	if err != nil {
+		return utils.APIError(err, "update", "loft_project_member", d.Id())
+	}
+
+	return projectMemberRead(ctx, d, meta)
+}
+
+func projectMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = patchProject(ctx, meta, managementClient, d.Get("project").(string), func(project *managementv1.Project) error {
+		i := findProjectMember(project.Spec.Members, d.Get("kind").(string), d.Get("group").(string), d.Get("name").(string))
+		if i >= 0 {
+			project.Spec.Members = append(project.Spec.Members[:i], project.Spec.Members[i+1:]...)
+		}
+		return nil
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return utils.APIError(err, "delete", "loft_project_member", d.Id())
+	}
+
+	return nil
+}
+
+func projectMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tokens := strings.Split(d.Id(), "/")
+	if len(tokens) != 3 {
+		return nil, fmt.Errorf("unexpected ID %q, expected `<project>/<kind>/<name>`", d.Id())
+	}
+
+	if err := d.Set("project", tokens[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("kind", tokens[1]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("group", storagev1.GroupVersion.Group); err != nil {
+		return nil, err
+	}
+	if err := d.Set("name", tokens[2]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
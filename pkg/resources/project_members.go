@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// projectMembers are the members of a project, which can also be managed by
+// `loft_project_member` resources.
+var projectMembers = sharedList{
+	attribute:  "members",
+	annotation: "terraform.loft.sh/authoritative-members",
+	resource:   "loft_project_member",
+}
+
+// patchProject applies mutate to the latest version of the project and
+// patches the difference. The patch carries the resource version, so it is
+// retried whenever the project was modified concurrently.
+func patchProject(ctx context.Context, meta interface{}, managementClient kube.Interface, name string, mutate func(project *managementv1.Project) error) error {
+	return loftclient.RetryOnConflict(meta, func() error {
+		oldProject, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		modifiedProject := oldProject.DeepCopy()
+		if err := mutate(modifiedProject); err != nil {
+			return err
+		}
+
+		patch := ctrlclient.MergeFromWithOptions(oldProject, ctrlclient.MergeFromWithOptimisticLock{})
+		rawPatch, err := patch.Data(modifiedProject)
+		if err != nil {
+			return err
+		}
+
+		_, err = managementClient.Loft().ManagementV1().Projects().Patch(ctx, name, patch.Type(), rawPatch, metav1.PatchOptions{})
+		return err
+	})
+}
+
+// projectListsData applies the lists of the project that are shared with
+// separate resources to metadata and spec. current is the project stored in
+// Loft and nil for new projects.
+func projectListsData(d *schema.ResourceData, metadata *metav1.ObjectMeta, spec *managementv1.ProjectSpec, current *managementv1.Project) {
+	projectMembers.configure(d, metadata)
+	projectAllowedClusters.configure(d, metadata)
+	projectAllowedTemplates.configure(d, metadata)
+	if current == nil {
+		return
+	}
+
+	if projectMembers.keep(d, current.ObjectMeta) {
+		spec.Members = current.Spec.Members
+	}
+	if projectAllowedClusters.keep(d, current.ObjectMeta) {
+		spec.AllowedClusters = current.Spec.AllowedClusters
+	}
+	if projectAllowedTemplates.keep(d, current.ObjectMeta) {
+		spec.AllowedTemplates = current.Spec.AllowedTemplates
+	}
+}
+
+// readProjectLists leaves the lists of the project that are managed by
+// separate resources out of spec.
+func readProjectLists(d *schema.ResourceData, meta interface{}, project *managementv1.Project, spec interface{}) {
+	projectMembers.read(d, meta, project.ObjectMeta, spec)
+	projectAllowedClusters.read(d, meta, project.ObjectMeta, spec)
+	projectAllowedTemplates.read(d, meta, project.ObjectMeta, spec)
+}
+
+// findProjectMember returns the index of the member with the given kind, group
+// and name or -1.
+func findProjectMember(members []storagev1.Member, kind, group, name string) int {
+	for i, member := range members {
+		if member.Kind == kind && member.Group == group && member.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectMembersDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `loft_project_members` data source lists the users and teams that have access to the given project, including users that are members through one of its teams.",
+		Schema:      projectMembersAttributes(),
+		ReadContext: projectMembersRead,
+	}
+}
+
+func projectMembersAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project.",
+			Required:    true,
+		},
+		"users": {
+			Type:        schema.TypeList,
+			Description: "The users that have access to the project, directly or through one of its teams.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the user.",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the user shown in the UI.",
+						Computed:    true,
+					},
+					"username": {
+						Type:        schema.TypeString,
+						Description: "The username the user logs in with.",
+						Computed:    true,
+					},
+					"email": {
+						Type:        schema.TypeString,
+						Description: "The email address of the user.",
+						Computed:    true,
+					},
+					"teams": {
+						Type:        schema.TypeList,
+						Description: "The project member teams the user is part of.",
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"cluster_roles": {
+						Type:        schema.TypeList,
+						Description: "The cluster roles assigned to the user, directly or through one of its teams.",
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"teams": {
+			Type:        schema.TypeList,
+			Description: "The teams that have access to the project.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the team.",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the team shown in the UI.",
+						Computed:    true,
+					},
+					"cluster_roles": {
+						Type:        schema.TypeList,
+						Description: "The cluster roles assigned to the team.",
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectName := d.Get("project").(string)
+	project, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, projectName, metav1.GetOptions{})
+	if err != nil {
+		return utils.APIError(err, "read", "loft_project", projectName)
+	}
+
+	members, err := managementClient.Loft().ManagementV1().Projects().ListMembers(ctx, projectName, metav1.GetOptions{})
+	if err != nil {
+		return utils.APIError(err, "list members of", "loft_project", projectName)
+	}
+
+	var diags diag.Diagnostics
+	teams := []interface{}{}
+	teamRoles := map[string][]string{}
+	userTeams := map[string][]string{}
+	for _, member := range members.Teams {
+		roles := memberClusterRoles(project.Spec.Members, "Team", member.Info.Name)
+		teamRoles[member.Info.Name] = roles
+		teams = append(teams, map[string]interface{}{
+			"name":          member.Info.Name,
+			"display_name":  member.Info.DisplayName,
+			"cluster_roles": roles,
+		})
+
+		team, err := managementClient.Loft().ManagementV1().Teams().Get(ctx, member.Info.Name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) || kerrors.IsForbidden(err) {
+			// the team might not be visible to the current user
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Could not read team %q of project %q", member.Info.Name, projectName),
+				Detail:   fmt.Sprintf("Users that are members of project %q only through team %q are not listed: %s", projectName, member.Info.Name, err),
+			})
+			continue
+		} else if err != nil {
+			return utils.APIError(err, "read", "team", member.Info.Name)
+		}
+		for _, user := range team.Spec.Users {
+			userTeams[user] = append(userTeams[user], member.Info.Name)
+		}
+	}
+
+	users := []interface{}{}
+	listed := map[string]bool{}
+	for _, member := range members.Users {
+		listed[member.Info.Name] = true
+		roles := memberClusterRoles(project.Spec.Members, "User", member.Info.Name)
+		for _, team := range userTeams[member.Info.Name] {
+			roles = append(roles, teamRoles[team]...)
+		}
+
+		users = append(users, map[string]interface{}{
+			"name":          member.Info.Name,
+			"display_name":  member.Info.DisplayName,
+			"username":      member.Info.Username,
+			"email":         member.Info.Email,
+			"teams":         userTeams[member.Info.Name],
+			"cluster_roles": uniqueSorted(roles),
+		})
+	}
+
+	// users that are members only through one of the teams
+	var teamUsers []string
+	for name := range userTeams {
+		if !listed[name] {
+			teamUsers = append(teamUsers, name)
+		}
+	}
+	sort.Strings(teamUsers)
+	for _, name := range teamUsers {
+		var roles []string
+		for _, team := range userTeams[name] {
+			roles = append(roles, teamRoles[team]...)
+		}
+
+		values := map[string]interface{}{
+			"name":          name,
+			"teams":         userTeams[name],
+			"cluster_roles": uniqueSorted(roles),
+		}
+		user, err := managementClient.Loft().ManagementV1().Users().Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			values["display_name"] = user.Spec.DisplayName
+			values["username"] = user.Spec.Username
+			values["email"] = user.Spec.Email
+		} else if !kerrors.IsNotFound(err) && !kerrors.IsForbidden(err) {
+			return utils.APIError(err, "read", "user", name)
+		}
+		users = append(users, values)
+	}
+
+	d.SetId(projectName)
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("teams", teams); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// memberClusterRoles returns the cluster roles the project members assign to
+// the user or team with the given name, including wildcard members.
+func memberClusterRoles(members []storagev1.Member, kind, name string) []string {
+	var roles []string
+	for _, member := range members {
+		if member.Kind != kind || member.ClusterRole == "" {
+			continue
+		}
+		if member.Name == name || member.Name == "*" {
+			roles = append(roles, member.ClusterRole)
+		}
+	}
+
+	return uniqueSorted(roles)
+}
+
+func uniqueSorted(values []string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		out = append(out, value)
+	}
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+)
+
+func quotaUsageSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The quota usage per " + kind + ".",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the " + kind + ".",
+				},
+				"used": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: "The amount currently used by the " + kind + ", keyed by quota resource.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectQuotaStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The current quota usage of the project as reported by Loft.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"project_limit": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: "The quotas enforced for the whole project.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"project_used": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: "The amount currently used across all clusters of the project.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"user_limit": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: "The quotas enforced per user / team.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"users": quotaUsageSchema("user"),
+				"teams": quotaUsageSchema("team"),
+			},
+		},
+	}
+}
+
+func readProjectQuotaStatus(status *storagev1.QuotaStatus) []interface{} {
+	if status == nil {
+		return nil
+	}
+
+	return []interface{}{projectQuotaStatusValues(status)}
+}
+
+// projectQuotaStatusValues converts the quota status into the attributes of
+// projectQuotaStatusSchema. Quotas Loft does not report are empty.
+func projectQuotaStatusValues(status *storagev1.QuotaStatus) map[string]interface{} {
+	values := map[string]interface{}{
+		"project_limit": map[string]string{},
+		"project_used":  map[string]string{},
+		"user_limit":    map[string]string{},
+		"users":         readQuotaUsage(nil),
+		"teams":         readQuotaUsage(nil),
+	}
+	if status.Project != nil {
+		values["project_limit"] = status.Project.Limit
+		values["project_used"] = status.Project.Used
+	}
+	if status.User != nil {
+		values["user_limit"] = status.User.Limit
+		values["users"] = readQuotaUsage(status.User.Used.Users)
+		values["teams"] = readQuotaUsage(status.User.Used.Teams)
+	}
+
+	return values
+}
+
+func readQuotaUsage(usage map[string]map[string]string) []interface{} {
+	names := make([]string, 0, len(usage))
+	for name := range usage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		values = append(values, map[string]interface{}{
+			"name": name,
+			"used": usage[name],
+		})
+	}
+
+	return values
+}
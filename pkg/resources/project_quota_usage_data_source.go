@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectQuotaUsageDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `loft_project_quota_usage` data source reads the quota limits and the current usage of the given project, in total, per cluster and per user / team.",
+		Schema:      projectQuotaUsageAttributes(),
+		ReadContext: projectQuotaUsageRead,
+	}
+}
+
+func projectQuotaUsageAttributes() map[string]*schema.Schema {
+	quotaMapSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeMap,
+			Description: description,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project.",
+			Required:    true,
+		},
+		"project_limit": quotaMapSchema("The quotas enforced for the whole project, keyed by quota resource."),
+		"project_used":  quotaMapSchema("The amount currently used across all clusters of the project, keyed by quota resource."),
+		"user_limit":    quotaMapSchema("The quotas enforced per user / team, keyed by quota resource."),
+		"users":         quotaUsageSchema("user"),
+		"teams":         quotaUsageSchema("team"),
+		"clusters": {
+			Type:        schema.TypeList,
+			Description: "The quota usage per cluster.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the cluster.",
+						Computed:    true,
+					},
+					"used":  quotaMapSchema("The amount of the project quotas currently used in the cluster, keyed by quota resource."),
+					"users": quotaUsageSchema("user"),
+					"teams": quotaUsageSchema("team"),
+				},
+			},
+		},
+	}
+}
+
+func projectQuotaUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project := d.Get("project").(string)
+	instance, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, project, metav1.GetOptions{})
+	if err != nil {
+		return utils.APIError(err, "read", "loft_project", project)
+	}
+
+	status := instance.Status.Quotas
+	if status == nil {
+		status = &storagev1.QuotaStatus{}
+	}
+
+	values := projectQuotaStatusValues(status)
+	values["clusters"] = readClusterQuotaUsage(status)
+
+	d.SetId(project)
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// readClusterQuotaUsage merges the per cluster usage of the project and user
+// quotas, which Loft reports separately.
+func readClusterQuotaUsage(status *storagev1.QuotaStatus) []interface{} {
+	names := map[string]bool{}
+	if status.Project != nil {
+		for name := range status.Project.Clusters {
+			names[name] = true
+		}
+	}
+	if status.User != nil {
+		for name := range status.User.Clusters {
+			names[name] = true
+		}
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	clusters := make([]interface{}, 0, len(sortedNames))
+	for _, name := range sortedNames {
+		cluster := map[string]interface{}{
+			"name":  name,
+			"used":  map[string]string{},
+			"users": readQuotaUsage(nil),
+			"teams": readQuotaUsage(nil),
+		}
+		if status.Project != nil {
+			if used := status.Project.Clusters[name].Used; used != nil {
+				cluster["used"] = used
+			}
+		}
+		if status.User != nil {
+			if used, ok := status.User.Clusters[name]; ok {
+				cluster["users"] = readQuotaUsage(used.Users)
+				cluster["teams"] = readQuotaUsage(used.Teams)
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
 	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
 	"github.com/loft-sh/terraform-provider-loft/pkg/schemas"
 	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,12 +42,14 @@ func projectAttributes() map[string]*schema.Schema {
 		},
 		"metadata": utils.MetadataSchema("Project", true, true),
 		"spec": {
-			Type: schema.TypeList,
+			Type:     schema.TypeList,
+			MaxItems: 1,
 			Elem: &schema.Resource{
 				Schema: schemas.ManagementV1ProjectSpecSchema(),
 			},
 			Required: true,
 		},
+		"quota_status": projectQuotaStatusSchema(),
 	}
 }
 
@@ -67,10 +70,10 @@ func projectRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	instance, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "read", "loft_project", d.Id())
 	}
 
-	metadata, err := utils.ReadMetadata(instance.ObjectMeta)
+	metadata, err := utils.ReadMetadata(instance.ObjectMeta, d.Get("metadata").([]interface{}), utils.ProviderMetadataOptions(meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -83,11 +86,17 @@ func projectRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	readProjectLists(d, meta, instance, spec)
+	accessRules.read(d, meta, instance.ObjectMeta, spec)
 
 	if err := d.Set("spec", []interface{}{spec}); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("quota_status", readProjectQuotaStatus(instance.Status.Quotas)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -102,16 +111,18 @@ func projectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.FromErr(err)
 	}
 
-	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}))
+	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}), utils.ProviderMetadataOptions(meta))
 
 	spec := schemas.CreateManagementV1ProjectSpec(d.Get("spec.0").(map[string]interface{}))
+	projectListsData(d, &metadata, spec, nil)
+	accessRules.configure(d, &metadata)
 
 	instance, err := managementClient.Loft().ManagementV1().Projects().Create(ctx, &managementv1.Project{
 		ObjectMeta: metadata,
 		Spec:       *spec,
 	}, metav1.CreateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "create", "loft_project", utils.ReadId(metadata))
 	}
 
 	d.SetId(utils.ReadId(instance.ObjectMeta))
@@ -130,26 +141,42 @@ func projectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.FromErr(err)
 	}
 	_, name := utils.ParseID(d.Id())
-	oldInstance, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	err = loftclient.RetryOnConflict(meta, func() error {
+		oldInstance, err := managementClient.Loft().ManagementV1().Projects().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
 
-	modifiedInstance := oldInstance.DeepCopy()
+		modifiedInstance := oldInstance.DeepCopy()
 
-	if d.HasChange("spec") {
-		if v, ok := d.Get("spec").([]interface{}); ok && len(v) > 0 {
-			modifiedInstance.Spec = *schemas.CreateManagementV1ProjectSpec(v[0].(map[string]interface{}))
+		if d.HasChange("metadata") {
+			oldMetadata, newMetadata := d.GetChange("metadata")
+			utils.UpdateMetadata(&modifiedInstance.ObjectMeta, oldMetadata.([]interface{}), newMetadata.([]interface{}), utils.ProviderMetadataOptions(meta))
 		}
-	}
 
-	patch := ctrlclient.MergeFrom(oldInstance)
-	rawPatch, err := patch.Data(modifiedInstance)
+		if d.HasChange("spec") {
+			if v, ok := d.Get("spec").([]interface{}); ok && len(v) > 0 {
+				modifiedInstance.Spec = *schemas.CreateManagementV1ProjectSpec(v[0].(map[string]interface{}))
+			}
+		}
+		projectListsData(d, &modifiedInstance.ObjectMeta, &modifiedInstance.Spec, oldInstance)
+
+		accessRules.configure(d, &modifiedInstance.ObjectMeta)
+		if accessRules.keep(d, oldInstance.ObjectMeta) {
+			modifiedInstance.Spec.Access = oldInstance.Spec.Access
+		}
+
+		patch := ctrlclient.MergeFromWithOptions(oldInstance, ctrlclient.MergeFromWithOptimisticLock{})
+		rawPatch, err := patch.Data(modifiedInstance)
+		if err != nil {
+			return err
+		}
+
+		_, err = managementClient.Loft().ManagementV1().Projects().Patch(ctx, name, patch.Type(), rawPatch, metav1.PatchOptions{})
+		return err
+	})
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	if _, err := managementClient.Loft().ManagementV1().Projects().Patch(ctx, name, patch.Type(), rawPatch, metav1.PatchOptions{}); err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "update", "loft_project", d.Id())
 	}
 
 	return projectRead(ctx, d, meta)
@@ -166,10 +193,10 @@ func projectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.FromErr(err)
 	}
 
-	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}))
+	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}), utils.ProviderMetadataOptions(meta))
 	err = managementClient.Loft().ManagementV1().Projects().Delete(ctx, metadata.Name, metav1.DeleteOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "delete", "loft_project", d.Id())
 	}
 
 	return nil
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectTemplatesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `loft_project_templates` data source lists the space and virtual cluster templates that instances of the given project may use.",
+		Schema:      projectTemplatesAttributes(),
+		ReadContext: projectTemplatesRead,
+	}
+}
+
+func projectTemplatesAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Type:        schema.TypeString,
+			Description: "The name of the project.",
+			Required:    true,
+		},
+		"default_space_template": {
+			Type:        schema.TypeString,
+			Description: "The name of the space template used by default in the project.",
+			Computed:    true,
+		},
+		"default_virtual_cluster_template": {
+			Type:        schema.TypeString,
+			Description: "The name of the virtual cluster template used by default in the project.",
+			Computed:    true,
+		},
+		"space_templates": {
+			Type:        schema.TypeList,
+			Description: "The space templates allowed in the project.",
+			Computed:    true,
+			Elem:        projectTemplateSchema(),
+		},
+		"virtual_cluster_templates": {
+			Type:        schema.TypeList,
+			Description: "The virtual cluster templates allowed in the project.",
+			Computed:    true,
+			Elem:        projectTemplateSchema(),
+		},
+	}
+}
+
+func projectTemplateSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the template, as used in `template_ref`.",
+				Computed:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the template shown in the UI.",
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the template.",
+				Computed:    true,
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Description: "The versions the template provides.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func projectTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	loftClient, ok := meta.(client.Client)
+	if !ok {
+		return diag.Errorf("Could not access loft client")
+	}
+
+	managementClient, err := loftClient.Management()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project := d.Get("project").(string)
+	projectTemplates, err := managementClient.Loft().ManagementV1().Projects().ListTemplates(ctx, project, metav1.GetOptions{})
+	if err != nil {
+		return utils.APIError(err, "list templates of", "loft_project", project)
+	}
+
+	spaceTemplates := []interface{}{}
+	for _, template := range projectTemplates.SpaceTemplates {
+		versions := []interface{}{}
+		for _, version := range template.Spec.Versions {
+			versions = append(versions, version.Version)
+		}
+
+		spaceTemplates = append(spaceTemplates, map[string]interface{}{
+			"name":         template.GetName(),
+			"display_name": template.Spec.DisplayName,
+			"description":  template.Spec.Description,
+			"versions":     versions,
+		})
+	}
+
+	virtualClusterTemplates := []interface{}{}
+	for _, template := range projectTemplates.VirtualClusterTemplates {
+		versions := []interface{}{}
+		for _, version := range template.Spec.Versions {
+			versions = append(versions, version.Version)
+		}
+
+		virtualClusterTemplates = append(virtualClusterTemplates, map[string]interface{}{
+			"name":         template.GetName(),
+			"display_name": template.Spec.DisplayName,
+			"description":  template.Spec.Description,
+			"versions":     versions,
+		})
+	}
+
+	d.SetId(project)
+	if err := d.Set("default_space_template", projectTemplates.DefaultSpaceTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("default_virtual_cluster_template", projectTemplates.DefaultVirtualClusterTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("space_templates", spaceTemplates); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("virtual_cluster_templates", virtualClusterTemplates); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
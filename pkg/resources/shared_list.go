@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// sharedList is a list of a spec that is either managed exclusively through
+// the spec block of its resource or entry by entry by a separate resource, like
+// the project members by `loft_project_member`.
+//
+// Configuring the list marks the object with the annotation, which makes the
+// separate resource fail. A list that is not configured is kept on updates and
+// left out on reads, so the entries of the separate resource neither get
+// removed nor show up as drift. Removing the list from the configuration of a
+// marked object clears it.
+//
+// Objects created before the annotation existed are not marked, so a list
+// already held in the state is still read until the next update marks the
+// object, and imports read the whole list.
+type sharedList struct {
+	// attribute is the name of the list in the spec block.
+	attribute string
+	// annotation marks objects whose list is managed through the spec block.
+	annotation string
+	// resource is the separate resource managing single entries.
+	resource string
+}
+
+// configure marks metadata if the list is configured and unmarks it otherwise.
+func (l sharedList) configure(d *schema.ResourceData, metadata *metav1.ObjectMeta) {
+	if utils.IsConfigured(d.GetRawConfig(), "spec.0."+l.attribute) {
+		if metadata.Annotations == nil {
+			metadata.Annotations = map[string]string{}
+		}
+		metadata.Annotations[l.annotation] = "true"
+		return
+	}
+
+	delete(metadata.Annotations, l.annotation)
+}
+
+// keep reports whether an update has to keep the list stored in Loft, which is
+// the case unless the list is configured or was configured before.
+func (l sharedList) keep(d *schema.ResourceData, current metav1.ObjectMeta) bool {
+	return !utils.IsConfigured(d.GetRawConfig(), "spec.0."+l.attribute) && !l.exclusive(current)
+}
+
+// read leaves the list out of the spec values unless it is managed through the
+// spec block or held in the state. Data sources read the whole list.
+func (l sharedList) read(d *schema.ResourceData, meta interface{}, metadata metav1.ObjectMeta, spec interface{}) {
+	values, ok := spec.(map[string]interface{})
+	if !ok || utils.IsDataSource(meta) || l.exclusive(metadata) || l.stored(d) {
+		return
+	}
+
+	delete(values, l.attribute)
+}
+
+// check returns an error if the list of the object is managed through the spec
+// block of the given resource type.
+func (l sharedList) check(metadata metav1.ObjectMeta, resourceType string) error {
+	if !l.exclusive(metadata) {
+		return nil
+	}
+
+	return fmt.Errorf("the %s of %s %q are managed exclusively by its `%s`, add the entry there instead of using %s", l.attribute, resourceType, metadata.Name, l.attribute, l.resource)
+}
+
+// stored reports whether the state holds the list, or no spec at all as after
+// an import.
+func (l sharedList) stored(d *schema.ResourceData) bool {
+	if specs, ok := d.Get("spec").([]interface{}); !ok || len(specs) == 0 {
+		return true
+	}
+
+	list, ok := d.Get("spec.0." + l.attribute).([]interface{})
+	return ok && len(list) > 0
+}
+
+func (l sharedList) exclusive(metadata metav1.ObjectMeta) bool {
+	return metadata.Annotations[l.annotation] == "true"
+}
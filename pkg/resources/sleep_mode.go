@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// sleepModeSchema adds the sleep mode attributes to the spec schema of an
+// instance, kind names the instance in the descriptions.
+func sleepModeSchema(spec map[string]*schema.Schema, kind string) map[string]*schema.Schema {
+	stored := " The sleep mode is stored in the annotations of the " + kind + ", so it can be combined with templateRef."
+
+	spec["sleep_after"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Description:      "SleepAfter puts the " + kind + " to sleep after the specified duration of inactivity. The format is a string accepted by the [time.ParseDuration](https://pkg.go.dev/time#ParseDuration) function, such as `\"1h\"`." + stored,
+		Optional:         true,
+		StateFunc:        utils.DurationToSeconds,
+		ValidateDiagFunc: utils.ValidateDuration,
+	}
+	spec["delete_after"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Description:      "DeleteAfter deletes the " + kind + " after the specified duration of inactivity. The format is a string accepted by the [time.ParseDuration](https://pkg.go.dev/time#ParseDuration) function, such as `\"1h\"`." + stored,
+		Optional:         true,
+		StateFunc:        utils.DurationToSeconds,
+		ValidateDiagFunc: utils.ValidateDuration,
+	}
+	spec["sleep_schedule"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Description:      "SleepSchedule puts the " + kind + " to sleep at certain times, such as `0 18 * * 1-5`. See [crontab.guru](https://crontab.guru/) for valid configurations." + stored,
+		Optional:         true,
+		ValidateDiagFunc: utils.ValidateCron,
+	}
+	spec["wakeup_schedule"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Description:      "WakeupSchedule wakes up the " + kind + " at certain times, such as `0 8 * * 1-5`. See [crontab.guru](https://crontab.guru/) for valid configurations." + stored,
+		Optional:         true,
+		ValidateDiagFunc: utils.ValidateCron,
+	}
+
+	return spec
+}
+
+// sleepModeData replaces the sleep mode annotations of the instance with the
+// sleep mode attributes set in the spec data.
+func sleepModeData(metadata *metav1.ObjectMeta, data map[string]interface{}) {
+	metadata.Annotations = utils.UpdateSleepMode(metadata.Annotations, data)
+}
+
+// readSleepMode sets the sleep mode attributes of spec from the annotations of
+// the instance.
+func readSleepMode(metadata metav1.ObjectMeta, spec interface{}) {
+	if values, ok := spec.(map[string]interface{}); ok {
+		utils.ReadSleepMode(metadata.Annotations, values)
+	}
+}
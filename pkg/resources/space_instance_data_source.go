@@ -41,6 +41,8 @@ func spaceInstanceDataSourceSchema() map[string]*schema.Schema {
 
 	attributes["spec"].Required = false
 	attributes["spec"].Computed = true
+	attributes["spec"].MaxItems = 0
+	utils.RemoveCrossFieldRules(attributes["spec"].Elem.(*schema.Resource).Schema)
 
 	return attributes
 }
@@ -52,5 +54,5 @@ func dataSourceSpaceInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.SetId(utils.ReadId(metadata))
 
-	return spaceInstanceRead(ctx, d, meta)
+	return spaceInstanceRead(ctx, d, utils.DataSourceMeta(meta))
 }
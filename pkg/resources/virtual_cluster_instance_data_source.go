@@ -41,6 +41,8 @@ func virtualClusterInstanceDataSourceSchema() map[string]*schema.Schema {
 
 	attributes["spec"].Required = false
 	attributes["spec"].Computed = true
+	attributes["spec"].MaxItems = 0
+	utils.RemoveCrossFieldRules(attributes["spec"].Elem.(*schema.Resource).Schema)
 
 	return attributes
 }
@@ -52,5 +54,5 @@ func dataSourceVirtualClusterInstanceRead(ctx context.Context, d *schema.Resourc
 	}
 	d.SetId(utils.ReadId(metadata))
 
-	return virtualClusterInstanceRead(ctx, d, meta)
+	return virtualClusterInstanceRead(ctx, d, utils.DataSourceMeta(meta))
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
 	"github.com/loft-sh/loftctl/v3/pkg/client"
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
 	"github.com/loft-sh/terraform-provider-loft/pkg/schemas"
 	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,8 +27,9 @@ func VirtualClusterInstanceResource() *schema.Resource {
 		ReadContext:   virtualClusterInstanceRead,
 		UpdateContext: virtualClusterInstanceUpdate,
 		DeleteContext: virtualClusterInstanceDelete,
+		CustomizeDiff: validateVirtualClusterInstanceParameters,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: virtualClusterInstanceImportState,
 		},
 	}
 }
@@ -41,9 +43,10 @@ func virtualClusterInstanceAttributes() map[string]*schema.Schema {
 		},
 		"metadata": utils.MetadataSchema("VirtualClusterInstance", true, false),
 		"spec": {
-			Type: schema.TypeList,
+			Type:     schema.TypeList,
+			MaxItems: 1,
 			Elem: &schema.Resource{
-				Schema: schemas.ManagementV1VirtualClusterInstanceSpecSchema(),
+				Schema: sleepModeSchema(schemas.ManagementV1VirtualClusterInstanceSpecSchema(), "virtual cluster instance"),
 			},
 			Required: true,
 		},
@@ -70,10 +73,10 @@ func virtualClusterInstanceRead(ctx context.Context, d *schema.ResourceData, met
 
 	instance, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "read", "loft_virtual_cluster_instance", d.Id())
 	}
 
-	metadata, err := utils.ReadMetadata(instance.ObjectMeta)
+	metadata, err := utils.ReadMetadata(instance.ObjectMeta, d.Get("metadata").([]interface{}), utils.ProviderMetadataOptions(meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,6 +90,12 @@ func virtualClusterInstanceRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
+	if err := readParametersMap(d, spec, instance.Spec.Parameters); err != nil {
+		return diag.FromErr(err)
+	}
+	readSleepMode(instance.ObjectMeta, spec)
+	accessRules.read(d, meta, instance.ObjectMeta, spec)
+
 	if err := d.Set("spec", []interface{}{spec}); err != nil {
 		return diag.FromErr(err)
 	}
@@ -105,16 +114,22 @@ func virtualClusterInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}))
+	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}), utils.ProviderMetadataOptions(meta))
 
-	spec := schemas.CreateManagementV1VirtualClusterInstanceSpec(d.Get("spec.0").(map[string]interface{}))
+	specData, err := instanceSpecData(d, d.Get("spec.0").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	spec := schemas.CreateManagementV1VirtualClusterInstanceSpec(specData)
+	sleepModeData(&metadata, specData)
+	accessRules.configure(d, &metadata)
 
 	instance, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(metadata.Namespace).Create(ctx, &managementv1.VirtualClusterInstance{
 		ObjectMeta: metadata,
 		Spec:       *spec,
 	}, metav1.CreateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "create", "loft_virtual_cluster_instance", utils.ReadId(metadata))
 	}
 
 	d.SetId(utils.ReadId(instance.ObjectMeta))
@@ -133,26 +148,56 @@ func virtualClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	namespace, name := utils.ParseID(d.Id())
-	oldInstance, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("metadata.0.namespace") {
+		// moving to another project keeps the workload instead of recreating it
+		targetNamespace := d.Get("metadata.0.namespace").(string)
+		if err := migrateVirtualClusterInstance(ctx, managementClient, d.Id(), targetNamespace); err != nil {
+			return utils.APIError(err, "migrate", "loft_virtual_cluster_instance", d.Id())
+		}
+
+		namespace = targetNamespace
+		d.SetId(namespace + "/" + name)
 	}
+	err = loftclient.RetryOnConflict(meta, func() error {
+		oldInstance, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
 
-	modifiedInstance := oldInstance.DeepCopy()
+		modifiedInstance := oldInstance.DeepCopy()
 
-	if d.HasChange("spec") {
-		if v, ok := d.Get("spec").([]interface{}); ok && len(v) > 0 {
-			modifiedInstance.Spec = *schemas.CreateManagementV1VirtualClusterInstanceSpec(v[0].(map[string]interface{}))
+		if d.HasChange("metadata") {
+			oldMetadata, newMetadata := d.GetChange("metadata")
+			utils.UpdateMetadata(&modifiedInstance.ObjectMeta, oldMetadata.([]interface{}), newMetadata.([]interface{}), utils.ProviderMetadataOptions(meta))
+		}
+
+		if d.HasChange("spec") {
+			if v, ok := d.Get("spec").([]interface{}); ok && len(v) > 0 {
+				specData, err := instanceSpecData(d, v[0].(map[string]interface{}))
+				if err != nil {
+					return err
+				}
+				modifiedInstance.Spec = *schemas.CreateManagementV1VirtualClusterInstanceSpec(specData)
+				sleepModeData(&modifiedInstance.ObjectMeta, specData)
+			}
+		}
+
+		accessRules.configure(d, &modifiedInstance.ObjectMeta)
+		if accessRules.keep(d, oldInstance.ObjectMeta) {
+			modifiedInstance.Spec.Access = oldInstance.Spec.Access
 		}
-	}
 
-	patch := ctrlclient.MergeFrom(oldInstance)
-	rawPatch, err := patch.Data(modifiedInstance)
+		patch := ctrlclient.MergeFromWithOptions(oldInstance, ctrlclient.MergeFromWithOptimisticLock{})
+		rawPatch, err := patch.Data(modifiedInstance)
+		if err != nil {
+			return err
+		}
+
+		_, err = managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Patch(ctx, name, patch.Type(), rawPatch, metav1.PatchOptions{})
+		return err
+	})
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	if _, err := managementClient.Loft().ManagementV1().VirtualClusterInstances(namespace).Patch(ctx, name, patch.Type(), rawPatch, metav1.PatchOptions{}); err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "update", "loft_virtual_cluster_instance", d.Id())
 	}
 
 	return virtualClusterInstanceRead(ctx, d, meta)
@@ -169,10 +214,10 @@ func virtualClusterInstanceDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}))
+	metadata := utils.CreateMetadata(d.Get("metadata").([]interface{}), utils.ProviderMetadataOptions(meta))
 	err = managementClient.Loft().ManagementV1().VirtualClusterInstances(metadata.Namespace).Delete(ctx, metadata.Name, metav1.DeleteOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return utils.APIError(err, "delete", "loft_virtual_cluster_instance", d.Id())
 	}
 
 	return nil
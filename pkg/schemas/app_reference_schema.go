@@ -24,9 +24,10 @@ func StorageV1AppReferenceSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"parameters": {
-			Type:        schema.TypeString,
-			Description: "Parameters to use for the app",
-			Optional:    true,
+			Type:             schema.TypeString,
+			Description:      "Parameters to use for the app",
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 		"release_name": {
 			Type:        schema.TypeString,
@@ -19,16 +19,15 @@ func ManagementV1ProjectSpecSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: StorageV1AccessSchema(),
 			},
-			Description: "Access holds the access rights for users and teams",
+			Description: "Access holds the access rights for users and teams. If set, this resource manages the list exclusively and `loft_project_access` resources of the same object fail. If not set, the entries added by `loft_project_access` are kept and not read back.",
 			Optional:    true,
-			Computed:    true,
 		},
 		"allowed_clusters": {
 			Type: schema.TypeList,
 			Elem: &schema.Resource{
 				Schema: StorageV1AllowedClusterSchema(),
 			},
-			Description: "AllowedClusters are target clusters that are allowed to target with environments.",
+			Description: "AllowedClusters are target clusters that are allowed to target with environments. If set, this resource manages the list exclusively and `loft_project_allowed_cluster` resources of the same object fail. If not set, the entries added by `loft_project_allowed_cluster` are kept and not read back.",
 			Optional:    true,
 		},
 		"allowed_templates": {
@@ -36,7 +35,7 @@ func ManagementV1ProjectSpecSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: StorageV1AllowedTemplateSchema(),
 			},
-			Description: "AllowedTemplates are the templates that are allowed to use in this project.",
+			Description: "AllowedTemplates are the templates that are allowed to use in this project. If set, this resource manages the list exclusively and `loft_project_allowed_template` resources of the same object fail. If not set, the entries added by `loft_project_allowed_template` are kept and not read back.",
 			Optional:    true,
 		},
 		"argo_c_d": {
@@ -64,7 +63,7 @@ func ManagementV1ProjectSpecSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: StorageV1MemberSchema(),
 			},
-			Description: "Members are the users and teams that are part of this project",
+			Description: "Members are the users and teams that are part of this project. If set, this resource manages the list exclusively and `loft_project_member` resources of the same object fail. If not set, the entries added by `loft_project_member` are kept and not read back.",
 			Optional:    true,
 		},
 		"namespace_pattern": {
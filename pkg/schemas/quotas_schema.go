@@ -18,16 +18,20 @@ func StorageV1QuotasSchema() map[string]*schema.Schema {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "Project holds the quotas for the whole project",
-			Optional:    true,
+			Description:      "Project holds the quotas for the whole project",
+			Optional:         true,
+			ValidateDiagFunc: utils.ValidateQuotas,
+			DiffSuppressFunc: utils.SuppressEquivalentQuantity,
 		},
 		"user": {
 			Type: schema.TypeMap,
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "User holds the quotas per user / team",
-			Optional:    true,
+			Description:      "User holds the quotas per user / team",
+			Optional:         true,
+			ValidateDiagFunc: utils.ValidateQuotas,
+			DiffSuppressFunc: utils.SuppressEquivalentQuantity,
 		},
 	}
 }
@@ -0,0 +1,103 @@
+package schemas_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/loft-sh/terraform-provider-loft/pkg/schemas"
+)
+
+// specResource nests the spec schema at `spec.0` like the resources do, which
+// the cross field rules of the spec schemas refer to.
+func specResource(spec map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"spec": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: spec,
+				},
+			},
+		},
+	}
+}
+
+func TestSpecSchemaRules(t *testing.T) {
+	specs := map[string]map[string]*schema.Schema{
+		"project":                  schemas.ManagementV1ProjectSpecSchema(),
+		"space instance":           schemas.ManagementV1SpaceInstanceSpecSchema(),
+		"virtual cluster instance": schemas.ManagementV1VirtualClusterInstanceSpecSchema(),
+	}
+
+	tests := []struct {
+		name      string
+		spec      map[string]interface{}
+		instances bool
+		wantError string
+	}{
+		{
+			name: "owner not configured",
+			spec: map[string]interface{}{"display_name": "test"},
+		},
+		{
+			name: "owner user",
+			spec: map[string]interface{}{"owner": []interface{}{map[string]interface{}{"user": "admin"}}},
+		},
+		{
+			name: "owner team",
+			spec: map[string]interface{}{"owner": []interface{}{map[string]interface{}{"team": "devs"}}},
+		},
+		{
+			name:      "owner user and team",
+			spec:      map[string]interface{}{"owner": []interface{}{map[string]interface{}{"user": "admin", "team": "devs"}}},
+			wantError: "only one of `spec.0.owner.0.team,spec.0.owner.0.user` can be specified",
+		},
+		{
+			name:      "owner without user or team",
+			spec:      map[string]interface{}{"owner": []interface{}{map[string]interface{}{}}},
+			wantError: "one of `spec.0.owner.0.team,spec.0.owner.0.user` must be specified",
+		},
+		{
+			name: "template and template ref",
+			spec: map[string]interface{}{
+				"template":     []interface{}{map[string]interface{}{"objects": "{}"}},
+				"template_ref": []interface{}{map[string]interface{}{"name": "isolated"}},
+			},
+			instances: true,
+			wantError: `"spec.0.template": conflicts with spec.0.template_ref`,
+		},
+	}
+
+	for kind, spec := range specs {
+		for _, tt := range tests {
+			if tt.instances && kind == "project" {
+				continue
+			}
+
+			t.Run(kind+"/"+tt.name, func(t *testing.T) {
+				config := terraform.NewResourceConfigRaw(map[string]interface{}{
+					"spec": []interface{}{tt.spec},
+				})
+
+				diags := specResource(spec).Validate(config)
+				if tt.wantError == "" {
+					if diags.HasError() {
+						t.Fatalf("expected no errors, got %v", diags)
+					}
+					return
+				}
+
+				for _, d := range diags {
+					if strings.Contains(d.Detail, tt.wantError) {
+						return
+					}
+				}
+				t.Fatalf("expected error %q, got %v", tt.wantError, diags)
+			})
+		}
+	}
+}
@@ -54,9 +54,10 @@ func StorageV1TemplateHelmChartSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"values": {
-			Type:        schema.TypeString,
-			Description: "Values are the values that should get passed to the chart",
-			Optional:    true,
+			Type:             schema.TypeString,
+			Description:      "Values are the values that should get passed to the chart",
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 		"version": {
 			Type:        schema.TypeString,
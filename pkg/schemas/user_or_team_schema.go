@@ -14,14 +14,16 @@ import (
 func StorageV1UserOrTeamSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"team": {
-			Type:        schema.TypeString,
-			Description: "Team specifies a Loft team.",
-			Optional:    true,
+			Type:         schema.TypeString,
+			Description:  "Team specifies a Loft team.",
+			Optional:     true,
+			ExactlyOneOf: []string{"spec.0.owner.0.user", "spec.0.owner.0.team"},
 		},
 		"user": {
-			Type:        schema.TypeString,
-			Description: "User specifies a Loft user.",
-			Optional:    true,
+			Type:         schema.TypeString,
+			Description:  "User specifies a Loft user.",
+			Optional:     true,
+			ExactlyOneOf: []string{"spec.0.owner.0.user", "spec.0.owner.0.team"},
 		},
 	}
 }
@@ -24,9 +24,10 @@ func StorageV1VirtualClusterHelmReleaseSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"values": {
-			Type:        schema.TypeString,
-			Description: "the values for the given chart",
-			Optional:    true,
+			Type:             schema.TypeString,
+			Description:      "the values for the given chart",
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 	}
 }
@@ -20,9 +20,8 @@ func ManagementV1VirtualClusterInstanceSpecSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: StorageV1AccessSchema(),
 			},
-			Description: "Access to the virtual cluster object itself",
+			Description: "Access to the virtual cluster object itself. If set, this resource manages the list exclusively and `loft_project_access` resources of the same object fail. If not set, the entries added by `loft_project_access` are kept and not read back.",
 			Optional:    true,
-			Computed:    true,
 		},
 		"cluster_ref": {
 			Type:     schema.TypeList,
@@ -65,9 +64,21 @@ func ManagementV1VirtualClusterInstanceSpecSchema() map[string]*schema.Schema {
 			Computed:    true,
 		},
 		"parameters": {
-			Type:        schema.TypeString,
-			Description: "Parameters are values to pass to the template",
-			Optional:    true,
+			Type:             schema.TypeString,
+			Description:      "Parameters are values to pass to the template",
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
+		},
+		"parameters_map": {
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description:   "ParametersMap is an alternative to parameters that holds the values to pass to the template as a map. Nested values are addressed with dotted keys like `resources.cpu`, values are parsed as YAML, so `3` and `true` are passed as number and boolean while `'3'` stays a string. This is mutually exclusive with parameters.",
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"spec.0.parameters"},
 		},
 		"template": {
 			Type:     schema.TypeList,
@@ -76,8 +87,9 @@ func ManagementV1VirtualClusterInstanceSpecSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: StorageV1VirtualClusterTemplateDefinitionSchema(),
 			},
-			Description: "Template is the inline template to use for virtual cluster creation. This is mutually exclusive with templateRef.",
-			Optional:    true,
+			Description:   "Template is the inline template to use for virtual cluster creation. This is mutually exclusive with templateRef.",
+			Optional:      true,
+			ConflictsWith: []string{"spec.0.template_ref"},
 		},
 		"template_ref": {
 			Type:     schema.TypeList,
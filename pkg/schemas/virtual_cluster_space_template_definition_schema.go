@@ -41,9 +41,10 @@ func StorageV1VirtualClusterSpaceTemplateDefinitionSchema() map[string]*schema.S
 			Optional:    true,
 		},
 		"objects": {
-			Type:        schema.TypeString,
-			Description: "Objects are Kubernetes style yamls that should get deployed into the virtual cluster namespace",
-			Optional:    true,
+			Type:             schema.TypeString,
+			Description:      "Objects are Kubernetes style yamls that should get deployed into the virtual cluster namespace",
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 	}
 }
@@ -73,9 +73,10 @@ func StorageV1VirtualClusterTemplateDefinitionSchema() map[string]*schema.Schema
 			Optional:    true,
 		},
 		"objects": {
-			Type:        schema.TypeString,
-			Description: "Objects are Kubernetes style yamls that should get deployed into the virtual cluster",
-			Optional:    true,
+			Type:             schema.TypeString,
+			Description:      "Objects are Kubernetes style yamls that should get deployed into the virtual cluster",
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressEquivalentYAML,
 		},
 		"space_template": {
 			Type:     schema.TypeList,
@@ -27,7 +27,7 @@ func MapToAttributes(rawMap map[string]string) map[string]interface{} {
 		attr[k] = v
 	}
 
-	if len(attr) > 0 {
+	if len(attr) == 0 {
 		return nil
 	}
 
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IsConfigured reports whether the attribute at key, e.g. `spec.0.parameters`,
+// is set to a non empty value in the raw configuration.
+func IsConfigured(config cty.Value, key string) bool {
+	value, ok := configValue(config, key)
+	return ok && !isEmptyValue(value)
+}
+
+func configValue(value cty.Value, key string) (cty.Value, bool) {
+	for _, part := range strings.Split(key, ".") {
+		if value.IsNull() || !value.IsKnown() {
+			return value, false
+		}
+
+		if index, err := strconv.Atoi(part); err == nil {
+			if !value.Type().IsListType() || value.LengthInt() <= index {
+				return value, false
+			}
+			value = value.Index(cty.NumberIntVal(int64(index)))
+			continue
+		}
+
+		if !value.Type().IsObjectType() || !value.Type().HasAttribute(part) {
+			return value, false
+		}
+		value = value.GetAttr(part)
+	}
+
+	return value, true
+}
+
+func isEmptyValue(value cty.Value) bool {
+	switch {
+	case value.IsNull() || !value.IsKnown():
+		return true
+	case value.Type() == cty.String:
+		return value.AsString() == ""
+	case value.Type() == cty.Bool:
+		return value.False()
+	case value.Type() == cty.Number:
+		return value.Equals(cty.Zero).True()
+	case value.CanIterateElements():
+		return value.LengthInt() == 0
+	}
+
+	return false
+}
+
+// RemoveCrossFieldRules removes ConflictsWith and ExactlyOneOf from the
+// attributes and their nested blocks. The rules refer to the attributes by
+// their absolute path, so they have to be removed when the attributes are
+// reused computed or at another path, e.g. in data sources.
+func RemoveCrossFieldRules(attributes map[string]*schema.Schema) {
+	for _, attribute := range attributes {
+		attribute.ConflictsWith = nil
+		attribute.ExactlyOneOf = nil
+		if elem, ok := attribute.Elem.(*schema.Resource); ok {
+			RemoveCrossFieldRules(elem.Schema)
+		}
+	}
+}
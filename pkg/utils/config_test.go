@@ -0,0 +1,33 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/loft-sh/terraform-provider-loft/pkg/utils"
+)
+
+func TestIsConfigured(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"spec": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"parameters":     cty.StringVal(""),
+				"parameters_map": cty.MapVal(map[string]cty.Value{"a": cty.StringVal("1")}),
+				"template":       cty.NullVal(cty.String),
+			}),
+		}),
+	})
+
+	tests := map[string]bool{
+		"spec.0.parameters":     false,
+		"spec.0.parameters_map": true,
+		"spec.0.template":       false,
+		"spec.0.unknown":        false,
+		"spec.1.parameters_map": false,
+	}
+	for key, want := range tests {
+		if got := utils.IsConfigured(config, key); got != want {
+			t.Errorf("expected %v for %s, got %v", want, key, got)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// APIError converts an error returned by a Loft API call into diagnostics that
+// name the operation and the object it was performed on, e.g.
+// `Failed to update loft_space_instance "loft-p-demo/my-space"`.
+func APIError(err error, operation, resourceType, id string) diag.Diagnostics {
+	detail := err.Error()
+
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		s := status.Status()
+		detail = fmt.Sprintf("%s\n\nStatus code: %d (%s)", detail, s.Code, s.Reason)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to %s %s %q", operation, resourceType, id),
+			Detail:   detail,
+		},
+	}
+}
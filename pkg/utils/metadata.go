@@ -2,6 +2,8 @@ package utils
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -48,7 +50,7 @@ func MetadataSchema(objectName string, generateName bool, clusterScope bool) *sc
 	if !clusterScope {
 		fields["namespace"] = &schema.Schema{
 			Type:        schema.TypeString,
-			Description: "Namespace defines the space within which each name must be unique. An empty namespace is equivalent to the \"default\" namespace, but \"default\" is the canonical representation. Not all objects are required to be scoped to a namespace - the value of this field for those objects will be empty.\n\nMust be a DNS_LABEL. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/namespaces",
+			Description: "Namespace of the project the object belongs to, in the format `loft-p-<project>`.\n\nMust be a DNS_LABEL. Changing the namespace migrates the object to the other project instead of recreating it. More info: http://kubernetes.io/docs/user-guide/namespaces",
 			Required:    true,
 		}
 	}
@@ -64,15 +66,53 @@ func MetadataSchema(objectName string, generateName bool, clusterScope bool) *sc
 	}
 }
 
-func ReadMetadata(metadata metav1.ObjectMeta) (interface{}, error) {
+// MetadataOptions holds the provider level settings for labels and
+// annotations.
+type MetadataOptions struct {
+	// IgnoreAnnotations and IgnoreLabels match keys that are managed outside of
+	// terraform, in addition to the keys owned by Kubernetes or Loft.
+	IgnoreAnnotations []*regexp.Regexp
+	IgnoreLabels      []*regexp.Regexp
+
+	// DefaultAnnotations and DefaultLabels are added to every object unless
+	// the resource sets the key itself.
+	DefaultAnnotations map[string]string
+	DefaultLabels      map[string]string
+}
+
+// ReadAnnotations returns the annotations that are managed through the
+// configuration, see RemoveInternalKeys and RemoveDefaults.
+func (o MetadataOptions) ReadAnnotations(annotations map[string]string, configured map[string]interface{}) map[string]string {
+	return RemoveDefaults(RemoveInternalKeys(annotations, configured, o.IgnoreAnnotations), configured, o.DefaultAnnotations)
+}
+
+// ReadLabels returns the labels that are managed through the configuration,
+// see RemoveInternalKeys and RemoveDefaults.
+func (o MetadataOptions) ReadLabels(labels map[string]string, configured map[string]interface{}) map[string]string {
+	return RemoveDefaults(RemoveInternalKeys(labels, configured, o.IgnoreLabels), configured, o.DefaultLabels)
+}
+
+// ReadMetadata converts the object metadata into the metadata block. Labels
+// and annotations owned by Kubernetes or Loft, ignored through options or
+// matching their default are left out unless they are part of the configured
+// metadata block.
+func ReadMetadata(metadata metav1.ObjectMeta, configured []interface{}, options MetadataOptions) (interface{}, error) {
 	meta := map[string]interface{}{}
 
-	annotations := MapToAttributes(metadata.Annotations)
+	var configuredAnnotations, configuredLabels map[string]interface{}
+	if HasValue(configured) {
+		if m, ok := configured[0].(map[string]interface{}); ok {
+			configuredAnnotations, _ = m["annotations"].(map[string]interface{})
+			configuredLabels, _ = m["labels"].(map[string]interface{})
+		}
+	}
+
+	annotations := MapToAttributes(options.ReadAnnotations(metadata.Annotations, configuredAnnotations))
 	if len(annotations) != 0 {
 		meta["annotations"] = annotations
 	}
 
-	labels := MapToAttributes(metadata.Labels)
+	labels := MapToAttributes(options.ReadLabels(metadata.Labels, configuredLabels))
 	if len(labels) != 0 {
 		meta["labels"] = labels
 	}
@@ -89,20 +129,21 @@ func ReadMetadata(metadata metav1.ObjectMeta) (interface{}, error) {
 	return meta, nil
 }
 
-func CreateMetadata(metadata []interface{}) metav1.ObjectMeta {
+// CreateMetadata converts the metadata block into object metadata, the
+// default labels and annotations of options are added unless the block sets
+// them.
+func CreateMetadata(metadata []interface{}, options MetadataOptions) metav1.ObjectMeta {
 	meta := metav1.ObjectMeta{}
 	if len(metadata) < 1 {
 		return meta
 	}
 	m := metadata[0].(map[string]interface{})
 
-	if v, ok := m["annotations"].(map[string]interface{}); ok && len(v) > 0 {
-		meta.Annotations = AttributesToMap(m["annotations"].(map[string]interface{}))
-	}
+	annotations, _ := m["annotations"].(map[string]interface{})
+	meta.Annotations = ApplyDefaults(AttributesToMap(annotations), annotations, options.DefaultAnnotations)
 
-	if v, ok := m["labels"].(map[string]interface{}); ok && len(v) > 0 {
-		meta.Labels = AttributesToMap(m["labels"].(map[string]interface{}))
-	}
+	labels, _ := m["labels"].(map[string]interface{})
+	meta.Labels = ApplyDefaults(AttributesToMap(labels), labels, options.DefaultLabels)
 
 	if v, ok := m["generate_name"]; ok {
 		meta.GenerateName = v.(string)
@@ -117,6 +158,147 @@ func CreateMetadata(metadata []interface{}) metav1.ObjectMeta {
 	return meta
 }
 
+// UpdateMetadata applies the changes between the old and new metadata block
+// to the labels and annotations of obj. Keys that are not part of either block
+// are kept, so labels and annotations owned by Kubernetes or Loft survive.
+// Defaults of options that are not part of the new block are restored.
+func UpdateMetadata(obj *metav1.ObjectMeta, oldMetadata, newMetadata []interface{}, options MetadataOptions) {
+	newAnnotations := metadataMap(newMetadata, "annotations")
+	obj.Annotations = updateKeys(obj.Annotations, metadataMap(oldMetadata, "annotations"), newAnnotations)
+	obj.Annotations = ApplyDefaults(obj.Annotations, newAnnotations, options.DefaultAnnotations)
+
+	newLabels := metadataMap(newMetadata, "labels")
+	obj.Labels = updateKeys(obj.Labels, metadataMap(oldMetadata, "labels"), newLabels)
+	obj.Labels = ApplyDefaults(obj.Labels, newLabels, options.DefaultLabels)
+}
+
+// ApplyDefaults returns a copy of m with the defaults that are not part of
+// configured.
+func ApplyDefaults(m map[string]string, configured map[string]interface{}, defaults map[string]string) map[string]string {
+	out := map[string]string{}
+	for k, v := range m {
+		out[k] = v
+	}
+	for k, v := range defaults {
+		if _, ok := configured[k]; !ok {
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+// RemoveDefaults returns a copy of m without the keys that still have their
+// default value and are not part of configured, so defaults never show up as
+// drift.
+func RemoveDefaults(m map[string]string, configured map[string]interface{}, defaults map[string]string) map[string]string {
+	out := map[string]string{}
+	for k, v := range m {
+		if _, ok := configured[k]; !ok {
+			if defaultValue, ok := defaults[k]; ok && defaultValue == v {
+				continue
+			}
+		}
+
+		out[k] = v
+	}
+
+	return out
+}
+
+func metadataMap(metadata []interface{}, key string) map[string]interface{} {
+	if !HasValue(metadata) {
+		return nil
+	}
+
+	m, ok := metadata[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	values, _ := m[key].(map[string]interface{})
+	return values
+}
+
+func updateKeys(current map[string]string, old, new map[string]interface{}) map[string]string {
+	updated := map[string]string{}
+	for k, v := range current {
+		updated[k] = v
+	}
+
+	for k := range old {
+		if _, ok := new[k]; !ok {
+			delete(updated, k)
+		}
+	}
+	for k, v := range new {
+		updated[k] = v.(string)
+	}
+
+	if len(updated) == 0 {
+		return nil
+	}
+
+	return updated
+}
+
+// RemoveInternalKeys returns a copy of the labels or annotations in m without
+// the keys owned by Kubernetes or Loft and the keys matching one of the ignore
+// patterns. Removed keys that are part of configured are kept.
+func RemoveInternalKeys(m map[string]string, configured map[string]interface{}, ignore []*regexp.Regexp) map[string]string {
+	out := map[string]string{}
+	for k, v := range m {
+		if _, ok := configured[k]; !ok && (IsInternalKey(k) || isIgnoredKey(k, ignore)) {
+			continue
+		}
+
+		out[k] = v
+	}
+
+	return out
+}
+
+func isIgnoredKey(key string, ignore []*regexp.Regexp) bool {
+	for _, pattern := range ignore {
+		if pattern.MatchString(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInternalKey reports whether the label or annotation key is owned by
+// Kubernetes or Loft.
+func IsInternalKey(annotationKey string) bool {
+	u, err := url.Parse("//" + annotationKey)
+	if err != nil {
+		return false
+	}
+
+	// allow user specified application specific keys
+	if u.Hostname() == "app.kubernetes.io" {
+		return false
+	}
+
+	// internal *.kubernetes.io keys
+	if strings.HasSuffix(u.Hostname(), "kubernetes.io") {
+		return true
+	}
+
+	// Specific to DaemonSet annotations, generated & controlled by the server.
+	if strings.Contains(annotationKey, "deprecated.daemonset.templates.generation") {
+		return true
+	}
+
+	// internal *.loft.sh keys
+	if strings.HasSuffix(u.Hostname(), "loft.sh") {
+		return true
+	}
+
+	return false
+}
+
 func metadataFields(objectName string) map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"annotations": {
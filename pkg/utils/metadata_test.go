@@ -0,0 +1,215 @@
+package utils
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReadMetadataRoundTrip(t *testing.T) {
+	configured := []interface{}{
+		map[string]interface{}{
+			"name":      "example",
+			"namespace": "loft-p-example",
+			"annotations": map[string]interface{}{
+				"description": "example space",
+			},
+			"labels": map[string]interface{}{
+				"team":                   "platform",
+				"app.kubernetes.io/name": "example",
+			},
+		},
+	}
+
+	metadata := CreateMetadata(configured, MetadataOptions{})
+	metadata.Annotations["loft.sh/managed"] = "true"
+	metadata.Labels["kubernetes.io/metadata.name"] = "example"
+
+	read, err := ReadMetadata(metadata, configured, MetadataOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := read.(map[string]interface{})
+	wantAnnotations := map[string]interface{}{"description": "example space"}
+	if !reflect.DeepEqual(values["annotations"], wantAnnotations) {
+		t.Errorf("expected annotations %v, got %v", wantAnnotations, values["annotations"])
+	}
+	wantLabels := map[string]interface{}{"team": "platform", "app.kubernetes.io/name": "example"}
+	if !reflect.DeepEqual(values["labels"], wantLabels) {
+		t.Errorf("expected labels %v, got %v", wantLabels, values["labels"])
+	}
+
+	if !reflect.DeepEqual(CreateMetadata([]interface{}{values}, MetadataOptions{}).Labels, map[string]string{"team": "platform", "app.kubernetes.io/name": "example"}) {
+		t.Errorf("expected labels to survive the round trip, got %v", CreateMetadata([]interface{}{values}, MetadataOptions{}).Labels)
+	}
+}
+
+func TestReadMetadataDrift(t *testing.T) {
+	metadata := metav1.ObjectMeta{
+		Name: "example",
+		Annotations: map[string]string{
+			"owner":                "someone",
+			"loft.sh/sleep-after":  "3600",
+			"loft.sh/display-name": "Example",
+		},
+	}
+
+	configured := []interface{}{
+		map[string]interface{}{
+			"annotations": map[string]interface{}{
+				"loft.sh/display-name": "Example",
+			},
+		},
+	}
+
+	read, err := ReadMetadata(metadata, configured, MetadataOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"owner": "someone", "loft.sh/display-name": "Example"}
+	if got := read.(map[string]interface{})["annotations"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected out of band and configured internal annotations %v, got %v", want, got)
+	}
+	if _, ok := read.(map[string]interface{})["labels"]; ok {
+		t.Errorf("expected no labels to be read")
+	}
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	obj := metav1.ObjectMeta{
+		Labels: map[string]string{
+			"team":            "platform",
+			"stage":           "dev",
+			"loft.sh/project": "example",
+		},
+	}
+
+	oldMetadata := []interface{}{map[string]interface{}{
+		"labels": map[string]interface{}{"team": "platform", "stage": "dev"},
+	}}
+	newMetadata := []interface{}{map[string]interface{}{
+		"labels":      map[string]interface{}{"team": "infra"},
+		"annotations": map[string]interface{}{"owner": "someone"},
+	}}
+
+	UpdateMetadata(&obj, oldMetadata, newMetadata, MetadataOptions{})
+
+	wantLabels := map[string]string{"team": "infra", "loft.sh/project": "example"}
+	if !reflect.DeepEqual(obj.Labels, wantLabels) {
+		t.Errorf("expected labels %v, got %v", wantLabels, obj.Labels)
+	}
+	wantAnnotations := map[string]string{"owner": "someone"}
+	if !reflect.DeepEqual(obj.Annotations, wantAnnotations) {
+		t.Errorf("expected annotations %v, got %v", wantAnnotations, obj.Annotations)
+	}
+}
+
+func TestIsInternalKey(t *testing.T) {
+	tests := map[string]bool{
+		"team":                         false,
+		"app.kubernetes.io/name":       false,
+		"kubernetes.io/metadata.name":  true,
+		"node.kubernetes.io/instance":  true,
+		"loft.sh/project":              true,
+		"virtualcluster.loft.sh/owner": true,
+		"example.com/owner":            false,
+	}
+
+	for key, want := range tests {
+		if got := IsInternalKey(key); got != want {
+			t.Errorf("expected %v for %q, got %v", want, key, got)
+		}
+	}
+}
+
+func TestReadMetadataIgnore(t *testing.T) {
+	metadata := metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"owner":                          "someone",
+			"argocd.argoproj.io/sync-wave":   "1",
+			"argocd.argoproj.io/tracking-id": "example",
+		},
+		Labels: map[string]string{
+			"team":                         "platform",
+			"app.kubernetes.io/managed-by": "argocd",
+		},
+	}
+
+	configured := []interface{}{
+		map[string]interface{}{
+			"annotations": map[string]interface{}{
+				"argocd.argoproj.io/sync-wave": "1",
+			},
+		},
+	}
+
+	read, err := ReadMetadata(metadata, configured, MetadataOptions{
+		IgnoreAnnotations: []*regexp.Regexp{regexp.MustCompile(`^argocd\.argoproj\.io/`)},
+		IgnoreLabels:      []*regexp.Regexp{regexp.MustCompile(`managed-by$`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := read.(map[string]interface{})
+	wantAnnotations := map[string]interface{}{"owner": "someone", "argocd.argoproj.io/sync-wave": "1"}
+	if !reflect.DeepEqual(values["annotations"], wantAnnotations) {
+		t.Errorf("expected annotations %v, got %v", wantAnnotations, values["annotations"])
+	}
+	wantLabels := map[string]interface{}{"team": "platform"}
+	if !reflect.DeepEqual(values["labels"], wantLabels) {
+		t.Errorf("expected labels %v, got %v", wantLabels, values["labels"])
+	}
+}
+
+func TestMetadataDefaults(t *testing.T) {
+	options := MetadataOptions{
+		DefaultLabels: map[string]string{
+			"cost-center": "1234",
+			"team":        "platform",
+		},
+	}
+
+	configured := []interface{}{
+		map[string]interface{}{
+			"name":   "example",
+			"labels": map[string]interface{}{"team": "infra"},
+		},
+	}
+
+	metadata := CreateMetadata(configured, options)
+	wantLabels := map[string]string{"cost-center": "1234", "team": "infra"}
+	if !reflect.DeepEqual(metadata.Labels, wantLabels) {
+		t.Errorf("expected labels %v, got %v", wantLabels, metadata.Labels)
+	}
+
+	read, err := ReadMetadata(metadata, configured, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRead := map[string]interface{}{"team": "infra"}
+	if got := read.(map[string]interface{})["labels"]; !reflect.DeepEqual(got, wantRead) {
+		t.Errorf("expected defaults not to be read back, got %v", got)
+	}
+
+	// a default that was changed out of band shows up as drift
+	metadata.Labels["cost-center"] = "5678"
+	read, err = ReadMetadata(metadata, configured, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRead = map[string]interface{}{"team": "infra", "cost-center": "5678"}
+	if got := read.(map[string]interface{})["labels"]; !reflect.DeepEqual(got, wantRead) {
+		t.Errorf("expected changed default to be read back, got %v", got)
+	}
+
+	// and is restored on update
+	UpdateMetadata(&metadata, []interface{}{read}, configured, options)
+	if !reflect.DeepEqual(metadata.Labels, wantLabels) {
+		t.Errorf("expected labels %v after update, got %v", wantLabels, metadata.Labels)
+	}
+}
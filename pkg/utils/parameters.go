@@ -0,0 +1,251 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ValidateParameters checks the parameters YAML of an instance against the
+// parameter definitions of its template. It reports unknown parameters,
+// missing required parameters and values that do not match the parameter type,
+// options or validation pattern.
+func ValidateParameters(definitions []storagev1.AppParameter, parameters string) []error {
+	values := map[string]interface{}{}
+	if strings.TrimSpace(parameters) != "" {
+		raw := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(parameters), &raw); err != nil {
+			return []error{fmt.Errorf("parameters must be a YAML map of parameter values: %w", err)}
+		}
+		flattenParameters("", raw, values)
+	}
+
+	byVariable := map[string]storagev1.AppParameter{}
+	for _, definition := range definitions {
+		byVariable[definition.Variable] = definition
+	}
+
+	var errs []error
+	for _, path := range sortedKeys(values) {
+		definition, ok := lookupParameter(byVariable, path)
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown parameter %q, the template defines %s", path, strings.Join(quotedVariables(definitions), ", ")))
+			continue
+		}
+		if definition.Variable != path {
+			// the whole object below the variable is its value
+			continue
+		}
+
+		if err := validateParameterValue(definition, values[path]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, definition := range definitions {
+		if !definition.Required || definition.DefaultValue != "" {
+			continue
+		}
+		if _, ok := lookupValue(values, definition.Variable); !ok {
+			errs = append(errs, fmt.Errorf("missing required parameter %q", definition.Variable))
+		}
+	}
+
+	return errs
+}
+
+func validateParameterValue(definition storagev1.AppParameter, value interface{}) error {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return fmt.Errorf("parameter %q must be a single value", definition.Variable)
+	}
+
+	str := fmt.Sprintf("%v", value)
+	if value == nil {
+		str = ""
+	}
+
+	switch definition.Type {
+	case "boolean":
+		if _, err := strconv.ParseBool(str); err != nil {
+			return fmt.Errorf("parameter %q must be a boolean, got %q", definition.Variable, str)
+		}
+	case "number":
+		number, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return fmt.Errorf("parameter %q must be a number, got %q", definition.Variable, str)
+		}
+		if definition.Min != nil && number < float64(*definition.Min) {
+			return fmt.Errorf("parameter %q must be at least %d, got %s", definition.Variable, *definition.Min, str)
+		}
+		if definition.Max != nil && number > float64(*definition.Max) {
+			return fmt.Errorf("parameter %q must be at most %d, got %s", definition.Variable, *definition.Max, str)
+		}
+	}
+
+	if len(definition.Options) > 0 {
+		found := false
+		for _, option := range definition.Options {
+			if option == str {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("parameter %q must be one of %s, got %q", definition.Variable, strings.Join(definition.Options, ", "), str)
+		}
+	}
+
+	if definition.Validation != "" {
+		if re, err := regexp.Compile(definition.Validation); err == nil && !re.MatchString(str) {
+			return fmt.Errorf("parameter %q must match %q, got %q", definition.Variable, definition.Validation, str)
+		}
+	}
+	if definition.Invalidation != "" {
+		if re, err := regexp.Compile(definition.Invalidation); err == nil && re.MatchString(str) {
+			return fmt.Errorf("parameter %q must not match %q, got %q", definition.Variable, definition.Invalidation, str)
+		}
+	}
+
+	return nil
+}
+
+// flattenParameters converts nested values into dotted paths, which is how
+// template parameters reference them, e.g. `resources.cpu`.
+func flattenParameters(prefix string, in map[string]interface{}, out map[string]interface{}) {
+	for key, value := range in {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			flattenParameters(path, nested, out)
+			continue
+		}
+
+		out[path] = value
+	}
+}
+
+func lookupParameter(definitions map[string]storagev1.AppParameter, path string) (storagev1.AppParameter, bool) {
+	if definition, ok := definitions[path]; ok {
+		return definition, true
+	}
+
+	for variable, definition := range definitions {
+		if strings.HasPrefix(path, variable+".") {
+			return definition, true
+		}
+	}
+
+	return storagev1.AppParameter{}, false
+}
+
+func lookupValue(values map[string]interface{}, variable string) (interface{}, bool) {
+	if value, ok := values[variable]; ok {
+		return value, true
+	}
+
+	for path, value := range values {
+		if strings.HasPrefix(path, variable+".") {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+func quotedVariables(definitions []storagev1.AppParameter) []string {
+	if len(definitions) == 0 {
+		return []string{"no parameters"}
+	}
+
+	variables := make([]string, 0, len(definitions))
+	for _, definition := range definitions {
+		variables = append(variables, strconv.Quote(definition.Variable))
+	}
+	sort.Strings(variables)
+
+	return variables
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ResolveTemplateVersion picks the template version an instance will use. An
+// empty version selects the latest one, `x` may be used as a wildcard for the
+// major, minor or patch version, e.g. `1.x.x`.
+func ResolveTemplateVersion(version string, versions []string) (string, bool) {
+	var (
+		best      string
+		bestParts []int
+	)
+	for _, candidate := range versions {
+		parts, ok := parseVersion(candidate)
+		if !ok || !matchesVersion(version, candidate) {
+			continue
+		}
+
+		if bestParts == nil || compareVersions(parts, bestParts) > 0 {
+			best, bestParts = candidate, parts
+		}
+	}
+
+	return best, bestParts != nil
+}
+
+func matchesVersion(pattern, version string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	patternParts := strings.Split(strings.TrimPrefix(pattern, "v"), ".")
+	versionParts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(patternParts) != len(versionParts) {
+		return false
+	}
+
+	for i := range patternParts {
+		if patternParts[i] != "x" && patternParts[i] != versionParts[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseVersion(version string) ([]int, bool) {
+	var parts []int
+	for _, part := range strings.Split(strings.TrimPrefix(version, "v"), ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false
+		}
+		parts = append(parts, n)
+	}
+
+	return parts, true
+}
+
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+
+	return len(a) - len(b)
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+)
+
+func TestValidateParameters(t *testing.T) {
+	one := 1
+	five := 5
+	definitions := []storagev1.AppParameter{
+		{Variable: "size", Type: "enum", Options: []string{"small", "large"}},
+		{Variable: "replicas", Type: "number", Min: &one, Max: &five},
+		{Variable: "ha", Type: "boolean"},
+		{Variable: "owner.email", Required: true, Validation: "^.+@.+$"},
+		{Variable: "region", Required: true, DefaultValue: "eu"},
+	}
+
+	tests := []struct {
+		name       string
+		parameters string
+		wantErrors []string
+	}{
+		{
+			name: "valid",
+			parameters: `
+size: small
+replicas: 3
+ha: true
+owner:
+  email: admin@example.com
+`,
+		},
+		{
+			name: "invalid",
+			parameters: `
+sise: small
+size: medium
+replicas: 7
+ha: maybe
+`,
+			wantErrors: []string{
+				`parameter "ha" must be a boolean, got "maybe"`,
+				`parameter "replicas" must be at most 5, got 7`,
+				`unknown parameter "sise"`,
+				`parameter "size" must be one of small, large, got "medium"`,
+				`missing required parameter "owner.email"`,
+			},
+		},
+		{
+			name:       "validation pattern",
+			parameters: "owner.email: nobody",
+			wantErrors: []string{`parameter "owner.email" must match "^.+@.+$", got "nobody"`},
+		},
+		{
+			name:       "not a map",
+			parameters: "- variable: size",
+			wantErrors: []string{"parameters must be a YAML map of parameter values"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateParameters(definitions, tt.parameters)
+			if len(errs) != len(tt.wantErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tt.wantErrors), errs)
+			}
+
+			for i, err := range errs {
+				if !strings.Contains(err.Error(), tt.wantErrors[i]) {
+					t.Errorf("expected error %q, got %q", tt.wantErrors[i], err)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveTemplateVersion(t *testing.T) {
+	versions := []string{"1.0.0", "1.2.0", "1.10.1", "2.0.0"}
+
+	tests := map[string]string{
+		"":       "2.0.0",
+		"1.x.x":  "1.10.1",
+		"1.2.x":  "1.2.0",
+		"x.x.x":  "2.0.0",
+		"1.0.0":  "1.0.0",
+		"3.x.x":  "",
+		"1.2":    "",
+		"1.10.1": "1.10.1",
+	}
+
+	for pattern, want := range tests {
+		got, ok := ResolveTemplateVersion(pattern, versions)
+		if got != want || ok != (want != "") {
+			t.Errorf("%q: expected %q, got %q (%t)", pattern, want, got, ok)
+		}
+	}
+}
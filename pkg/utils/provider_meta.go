@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
+)
+
+// ProviderMeta is the provider meta handed to the resources. It embeds the
+// wrapped Loft client, so resources can keep type asserting the meta to
+// client.Client, and adds the settings that only matter to the resources.
+type ProviderMeta struct {
+	*loftclient.Client
+
+	// Metadata configures how resources handle labels and annotations.
+	Metadata MetadataOptions
+
+	// DataSource is set for the reads of data sources, which report the
+	// lists that resources leave to other resources, too.
+	DataSource bool
+}
+
+// ProviderMetadataOptions returns the label and annotation settings of the
+// provider meta, the zero value is returned if the meta does not hold any.
+func ProviderMetadataOptions(meta interface{}) MetadataOptions {
+	if m, ok := meta.(*ProviderMeta); ok {
+		return m.Metadata
+	}
+
+	return MetadataOptions{}
+}
+
+// DataSourceMeta returns the provider meta for the reads of data sources. The
+// label and annotation settings are left out, data sources read the metadata
+// as it is.
+func DataSourceMeta(meta interface{}) interface{} {
+	if m, ok := meta.(*ProviderMeta); ok {
+		return &ProviderMeta{Client: m.Client, DataSource: true}
+	}
+
+	return meta
+}
+
+// IsDataSource reports whether the meta was returned by DataSourceMeta.
+func IsDataSource(meta interface{}) bool {
+	m, ok := meta.(*ProviderMeta)
+	return ok && m.DataSource
+}
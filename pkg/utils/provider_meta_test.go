@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/loft-sh/terraform-provider-loft/pkg/loftclient"
+)
+
+func TestDataSourceMeta(t *testing.T) {
+	meta := &ProviderMeta{
+		Client: &loftclient.Client{},
+		Metadata: MetadataOptions{
+			DefaultLabels: map[string]string{"team": "platform"},
+		},
+	}
+
+	dataSourceMeta, ok := DataSourceMeta(meta).(*ProviderMeta)
+	if !ok {
+		t.Fatalf("expected a *ProviderMeta, got %T", DataSourceMeta(meta))
+	}
+	if dataSourceMeta.Client != meta.Client {
+		t.Errorf("expected the client to be kept")
+	}
+	if !reflect.DeepEqual(ProviderMetadataOptions(dataSourceMeta), MetadataOptions{}) {
+		t.Errorf("expected no metadata options, got %+v", ProviderMetadataOptions(dataSourceMeta))
+	}
+	if !IsDataSource(dataSourceMeta) || IsDataSource(meta) {
+		t.Errorf("expected only the data source meta to be marked as data source")
+	}
+	if len(meta.Metadata.DefaultLabels) != 1 {
+		t.Errorf("expected the provider meta not to be modified")
+	}
+}
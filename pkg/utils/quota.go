@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// quotaResourceNames are the quota keys understood by Loft. Besides the Loft
+// specific instance counts these are the names supported by Kubernetes
+// resource quotas.
+var quotaResourceNames = map[string]bool{
+	"spaceinstances":             true,
+	"virtualclusterinstances":    true,
+	"cpu":                        true,
+	"memory":                     true,
+	"ephemeral-storage":          true,
+	"requests.cpu":               true,
+	"requests.memory":            true,
+	"requests.storage":           true,
+	"requests.ephemeral-storage": true,
+	"limits.cpu":                 true,
+	"limits.memory":              true,
+	"limits.ephemeral-storage":   true,
+	"pods":                       true,
+	"services":                   true,
+	"services.loadbalancers":     true,
+	"services.nodeports":         true,
+	"secrets":                    true,
+	"configmaps":                 true,
+	"persistentvolumeclaims":     true,
+	"replicationcontrollers":     true,
+	"resourcequotas":             true,
+}
+
+var quotaResourcePatterns = []*regexp.Regexp{
+	// object counts, e.g. count/deployments.apps
+	regexp.MustCompile(`^count/[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`),
+	// per storage class quotas, e.g. gold.storageclass.storage.k8s.io/requests.storage
+	regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?\.storageclass\.storage\.k8s\.io/(requests\.storage|persistentvolumeclaims)$`),
+	// huge pages, e.g. requests.hugepages-2Mi
+	regexp.MustCompile(`^(requests\.)?hugepages-[0-9]+[a-zA-Z]+$`),
+	// extended resources, e.g. requests.nvidia.com/gpu
+	regexp.MustCompile(`^requests\.[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)+/[a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?$`),
+}
+
+// IsQuotaResourceName reports whether name is a known quota resource name.
+func IsQuotaResourceName(name string) bool {
+	if quotaResourceNames[name] {
+		return true
+	}
+
+	for _, pattern := range quotaResourcePatterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateQuotas validates a map of quota resource names to quantities, so
+// typos in either are reported during plan instead of by the Loft API.
+func ValidateQuotas(i interface{}, path cty.Path) diag.Diagnostics {
+	quotas, ok := i.(map[string]interface{})
+	if !ok {
+		return diag.Errorf("expected a map of quotas, got %T", i)
+	}
+
+	keys := make([]string, 0, len(quotas))
+	for key := range quotas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var diags diag.Diagnostics
+	for _, key := range keys {
+		attributePath := path.IndexString(key)
+		if !IsQuotaResourceName(key) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Unknown quota resource %q", key),
+				Detail:        "Quotas must use a Loft instance count (spaceinstances, virtualclusterinstances) or a Kubernetes resource quota name such as requests.cpu, limits.memory, pods or count/deployments.apps.",
+				AttributePath: attributePath,
+			})
+			continue
+		}
+
+		value, _ := quotas[key].(string)
+		if _, err := resource.ParseQuantity(value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid quantity %q for quota %q", value, key),
+				Detail:        "Quota values must be Kubernetes resource quantities such as 500m, 2, 10Gi or 1e3.",
+				AttributePath: attributePath,
+			})
+		}
+	}
+
+	return diags
+}
+
+// SuppressEquivalentQuantity suppresses diffs between quantities that are
+// written differently but describe the same amount, such as `1000m` and `1`.
+func SuppressEquivalentQuantity(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+
+	return EquivalentQuantity(old, new)
+}
+
+// EquivalentQuantity reports whether both strings parse to the same resource
+// quantity.
+func EquivalentQuantity(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	quantityA, err := resource.ParseQuantity(a)
+	if err != nil {
+		return false
+	}
+	quantityB, err := resource.ParseQuantity(b)
+	if err != nil {
+		return false
+	}
+
+	return quantityA.Cmp(quantityB) == 0
+}
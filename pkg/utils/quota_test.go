@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateQuotas(t *testing.T) {
+	valid := map[string]interface{}{
+		"spaceinstances":          "10",
+		"virtualclusterinstances": "5",
+		"requests.cpu":            "1500m",
+		"limits.memory":           "10Gi",
+		"count/deployments.apps":  "20",
+		"gold.storageclass.storage.k8s.io/requests.storage": "500Gi",
+		"requests.nvidia.com/gpu":                           "4",
+		"requests.hugepages-2Mi":                            "1Gi",
+	}
+	if diags := ValidateQuotas(valid, cty.GetAttrPath("project")); diags.HasError() {
+		t.Errorf("expected valid quotas, got %v", diags)
+	}
+
+	invalid := map[string]interface{}{
+		"requests.cpuu": "1",
+		"limits.memory": "10 Gi",
+		"pods":          "ten",
+	}
+	diags := ValidateQuotas(invalid, cty.GetAttrPath("project"))
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 errors, got %v", diags)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("project").IndexString("limits.memory")) {
+		t.Errorf("expected error for limits.memory first, got %v", diags[0].AttributePath)
+	}
+}
+
+func TestEquivalentQuantity(t *testing.T) {
+	if !EquivalentQuantity("1000m", "1") {
+		t.Errorf("expected 1000m to equal 1")
+	}
+	if !EquivalentQuantity("1Gi", "1024Mi") {
+		t.Errorf("expected 1Gi to equal 1024Mi")
+	}
+	if EquivalentQuantity("1G", "1Gi") {
+		t.Errorf("expected 1G not to equal 1Gi")
+	}
+	if EquivalentQuantity("", "1") {
+		t.Errorf("expected an unset quantity not to equal 1")
+	}
+}
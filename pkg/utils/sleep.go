@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	agentv1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/cluster/v1"
+	"github.com/robfig/cron/v3"
+)
+
+// sleepModeAnnotations maps the sleep mode attributes to the annotations Loft
+// reads the sleep mode configuration from.
+var sleepModeAnnotations = map[string]string{
+	"sleep_after":     agentv1.SleepModeSleepAfterAnnotation,
+	"delete_after":    agentv1.SleepModeDeleteAfterAnnotation,
+	"sleep_schedule":  agentv1.SleepModeSleepScheduleAnnotation,
+	"wakeup_schedule": agentv1.SleepModeWakeupScheduleAnnotation,
+}
+
+// SleepModeAnnotations converts the sleep mode attributes set in data to the
+// annotations understood by Loft. Durations are stored in seconds.
+func SleepModeAnnotations(data map[string]interface{}) map[string]string {
+	annotations := map[string]string{}
+	for attribute, annotation := range sleepModeAnnotations {
+		value, _ := data[attribute].(string)
+		if value == "" {
+			continue
+		}
+
+		if attribute == "sleep_after" || attribute == "delete_after" {
+			// values read back from Loft are already in seconds
+			if seconds := DurationToSeconds(value); seconds != "" {
+				value = seconds
+			}
+		}
+		annotations[annotation] = value
+	}
+
+	return annotations
+}
+
+// UpdateSleepMode replaces the sleep mode annotations in annotations with the
+// ones of the attributes set in data and returns the resulting annotations.
+func UpdateSleepMode(annotations map[string]string, data map[string]interface{}) map[string]string {
+	for _, annotation := range sleepModeAnnotations {
+		delete(annotations, annotation)
+	}
+
+	configured := SleepModeAnnotations(data)
+	if len(configured) > 0 && annotations == nil {
+		annotations = map[string]string{}
+	}
+	for key, value := range configured {
+		annotations[key] = value
+	}
+
+	return annotations
+}
+
+// ReadSleepMode sets the sleep mode attributes in values from annotations.
+func ReadSleepMode(annotations map[string]string, values map[string]interface{}) {
+	for attribute, annotation := range sleepModeAnnotations {
+		values[attribute] = annotations[annotation]
+	}
+}
+
+// ValidateDuration validates that the value is accepted by time.ParseDuration.
+func ValidateDuration(v interface{}, _ cty.Path) diag.Diagnostics {
+	valStr := v.(string)
+
+	_, err := time.ParseDuration(valStr)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// DurationToSeconds converts a duration like `1h` to the number of seconds
+// Loft stores in the sleep mode annotations.
+func DurationToSeconds(val interface{}) string {
+	valStr, ok := val.(string)
+	if !ok {
+		return ""
+	}
+
+	duration, err := time.ParseDuration(valStr)
+	if err != nil {
+		return ""
+	}
+
+	return strconv.Itoa(int(duration.Seconds()))
+}
+
+// ValidateCron validates that the value is a standard cron expression, which
+// may be prefixed with a time zone like `CRON_TZ=Europe/Berlin`.
+func ValidateCron(v interface{}, _ cty.Path) diag.Diagnostics {
+	valStr := v.(string)
+
+	if _, err := cron.ParseStandard(valStr); err != nil {
+		return diag.FromErr(fmt.Errorf("invalid cron expression %q: %w", valStr, err))
+	}
+
+	return nil
+}
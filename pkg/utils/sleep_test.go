@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	agentv1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/cluster/v1"
+)
+
+func TestSleepModeAnnotationsRoundTrip(t *testing.T) {
+	annotations := SleepModeAnnotations(map[string]interface{}{
+		"sleep_after":     "1h",
+		"delete_after":    "86400",
+		"sleep_schedule":  "0 18 * * 1-5",
+		"wakeup_schedule": "",
+	})
+
+	want := map[string]string{
+		agentv1.SleepModeSleepAfterAnnotation:    "3600",
+		agentv1.SleepModeDeleteAfterAnnotation:   "86400",
+		agentv1.SleepModeSleepScheduleAnnotation: "0 18 * * 1-5",
+	}
+	if !reflect.DeepEqual(annotations, want) {
+		t.Fatalf("expected %v, got %v", want, annotations)
+	}
+
+	values := map[string]interface{}{}
+	ReadSleepMode(annotations, values)
+	if values["sleep_after"] != "3600" || values["wakeup_schedule"] != "" {
+		t.Errorf("unexpected sleep mode values %v", values)
+	}
+}
+
+func TestUpdateSleepMode(t *testing.T) {
+	annotations := map[string]string{
+		agentv1.SleepModeSleepAfterAnnotation:    "3600",
+		agentv1.SleepModeSleepScheduleAnnotation: "0 18 * * 1-5",
+		"other":                                  "value",
+	}
+
+	annotations = UpdateSleepMode(annotations, map[string]interface{}{
+		"sleep_after": "30m",
+	})
+
+	want := map[string]string{
+		agentv1.SleepModeSleepAfterAnnotation: "1800",
+		"other":                               "value",
+	}
+	if !reflect.DeepEqual(annotations, want) {
+		t.Errorf("expected %v, got %v", want, annotations)
+	}
+
+	if annotations := UpdateSleepMode(nil, map[string]interface{}{}); annotations != nil {
+		t.Errorf("expected no annotations, got %v", annotations)
+	}
+}
+
+func TestValidateCron(t *testing.T) {
+	for _, expression := range []string{"0 18 * * 1-5", "CRON_TZ=Europe/Berlin 0 8 * * *", "@daily"} {
+		if diags := ValidateCron(expression, nil); diags.HasError() {
+			t.Errorf("expected %q to be valid, got %v", expression, diags)
+		}
+	}
+	for _, expression := range []string{"0 18 * *", "every day", "0 25 * * *"} {
+		if diags := ValidateCron(expression, nil); !diags.HasError() {
+			t.Errorf("expected %q to be invalid", expression)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// SuppressEquivalentYAML suppresses diffs between YAML documents that only
+// differ in formatting, key order, quoting or comments, as the Loft API
+// reformats YAML it stores.
+func SuppressEquivalentYAML(k, old, new string, d *schema.ResourceData) bool {
+	return EquivalentYAML(old, new)
+}
+
+// EquivalentYAML reports whether both YAML strings describe the same values.
+// Multiple documents separated by `---` are compared in order, empty
+// documents are ignored.
+func EquivalentYAML(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	objA, err := decodeYAMLDocuments(a)
+	if err != nil {
+		return false
+	}
+	objB, err := decodeYAMLDocuments(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(objA, objB)
+}
+
+func decodeYAMLDocuments(in string) ([]interface{}, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(in)))
+
+	var documents []interface{}
+	for {
+		raw, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		var document interface{}
+		if err := yaml.Unmarshal(raw, &document); err != nil {
+			return nil, err
+		}
+		if document == nil {
+			continue
+		}
+
+		documents = append(documents, document)
+	}
+
+	return documents, nil
+}
+
+// ParametersFromMap converts a flat map of parameters into the YAML expected
+// by Spec.Parameters. Dotted keys become nested maps and every value is parsed
+// as YAML, so `"3"` becomes a number and `"true"` a boolean while `"'3'"`
+// stays a string. A key that is set as a value and as a prefix of another
+// key, like `a` and `a.b`, is an error.
+func ParametersFromMap(parameters map[string]interface{}) (string, error) {
+	if len(parameters) == 0 {
+		return "", nil
+	}
+
+	keys := make([]string, 0, len(parameters))
+	for key := range parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	out := map[string]interface{}{}
+	for _, key := range keys {
+		str, _ := parameters[key].(string)
+
+		var value interface{} = str
+		var parsed interface{}
+		if err := yaml.Unmarshal([]byte(str), &parsed); err == nil {
+			switch parsed.(type) {
+			case string, bool, float64, int64, nil:
+				value = parsed
+			}
+		}
+		if str == "" {
+			value = ""
+		}
+
+		segments := strings.Split(key, ".")
+		current := out
+		for i, segment := range segments[:len(segments)-1] {
+			existing, ok := current[segment]
+			if !ok {
+				existing = map[string]interface{}{}
+				current[segment] = existing
+			}
+
+			next, ok := existing.(map[string]interface{})
+			if !ok {
+				return "", fmt.Errorf("parameter %q conflicts with parameter %q", key, strings.Join(segments[:i+1], "."))
+			}
+			current = next
+		}
+
+		last := segments[len(segments)-1]
+		if _, ok := current[last].(map[string]interface{}); ok {
+			return "", fmt.Errorf("parameter %q conflicts with the nested parameters %q", key, key+".*")
+		}
+		current[last] = value
+	}
+
+	raw, err := yaml.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// ParametersToMap is the inverse of ParametersFromMap, it flattens the
+// parameters YAML into dotted keys with their values encoded as YAML scalars.
+func ParametersToMap(parameters string) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	if strings.TrimSpace(parameters) == "" {
+		return out, nil
+	}
+
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(parameters), &raw); err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	flattenParameters("", raw, values)
+
+	for key, value := range values {
+		switch v := value.(type) {
+		case nil:
+			out[key] = ""
+		case string:
+			// strings that would be parsed as another type need quotes
+			var parsed interface{}
+			if err := yaml.Unmarshal([]byte(v), &parsed); err == nil && v != "" {
+				switch parsed.(type) {
+				case bool, float64, int64, nil:
+					v = "'" + strings.ReplaceAll(v, "'", "''") + "'"
+				}
+			}
+			out[key] = v
+		case bool:
+			out[key] = strconv.FormatBool(v)
+		case float64:
+			out[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = string(encoded)
+		}
+	}
+
+	return out, nil
+}
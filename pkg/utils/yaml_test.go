@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEquivalentYAML(t *testing.T) {
+	if !EquivalentYAML("a: 1\nb:\n  c: 'x'\n", "b: {c: x}\na: 1") {
+		t.Errorf("expected reformatted YAML to be equivalent")
+	}
+	if EquivalentYAML("a: 1", "a: 2") {
+		t.Errorf("expected different values not to be equivalent")
+	}
+
+	objects := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: b\n"
+	if !EquivalentYAML(objects, "---\n# config\nkind: ConfigMap\napiVersion: v1\nmetadata: {name: a}\n---\n---\nkind: Secret\napiVersion: v1\nmetadata: {name: b}\n") {
+		t.Errorf("expected reformatted documents to be equivalent")
+	}
+	if EquivalentYAML(objects, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: c\n") {
+		t.Errorf("expected documents after the first one to be compared")
+	}
+}
+
+func TestParametersMapRoundTrip(t *testing.T) {
+	in := map[string]interface{}{
+		"replicas":       "3",
+		"ha":             "true",
+		"owner.email":    "admin@example.com",
+		"owner.team":     "'42'",
+		"resources.cpus": "[1, 2]",
+	}
+
+	parameters, err := ParametersFromMap(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "ha: true\nowner:\n  email: admin@example.com\n  team: \"42\"\nreplicas: 3\nresources:\n  cpus: '[1, 2]'\n"
+	if parameters != want {
+		t.Errorf("expected YAML\n%s\ngot\n%s", want, parameters)
+	}
+
+	out, err := ParametersToMap(parameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("expected %v after round trip, got %v", in, out)
+	}
+}
+
+func TestParametersFromMapConflicts(t *testing.T) {
+	for _, in := range []map[string]interface{}{
+		{"a": "1", "a.b": "2"},
+		{"a.b": "1", "a.b.c": "2"},
+		{"a.b.c": "1", "a.b": "2", "b": "3"},
+	} {
+		for i := 0; i < 10; i++ {
+			if _, err := ParametersFromMap(in); err == nil {
+				t.Fatalf("expected an error for %v", in)
+			}
+		}
+	}
+
+	in := map[string]interface{}{"a.b": "1", "a.c": "2", "b": "3", "c.d.e": "4"}
+	want, err := ParametersFromMap(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		parameters, err := ParametersFromMap(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parameters != want {
+			t.Fatalf("expected the same YAML on every call, got\n%s\nand\n%s", want, parameters)
+		}
+	}
+}
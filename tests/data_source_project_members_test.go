@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	storagev1 "github.com/loft-sh/api/v3/pkg/apis/storage/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func TestAccDataSourceProjectMembers(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+	user := "admin"
+	clusterRole := "loft-management-project-admin"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	teamUser, team, err := createTeamWithUser(kubeClient)
+	if teamUser != "" {
+		defer deleteTeamWithUser(t, kubeClient, teamUser, team)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectMembersCreate(configPath, projectName, user, team, clusterRole),
+			},
+			{
+				Config: testAccDataSourceProjectMembersCreate(configPath, projectName, user, team, clusterRole) +
+					testAccDataSourceProjectMembersRead(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.loft_project_members.test", "id", projectName),
+					resource.TestCheckTypeSetElemNestedAttrs("data.loft_project_members.test", "users.*", map[string]string{
+						"name":            user,
+						"cluster_roles.#": "1",
+						"cluster_roles.0": clusterRole,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.loft_project_members.test", "users.*", map[string]string{
+						"name":            teamUser,
+						"username":        teamUser,
+						"teams.#":         "1",
+						"teams.0":         team,
+						"cluster_roles.#": "1",
+						"cluster_roles.0": "loft-management-project-user",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func createTeamWithUser(kubeClient kube.Interface) (string, string, error) {
+	user := names.SimpleNameGenerator.GenerateName("team-user-")
+	_, err := kubeClient.Loft().StorageV1().Users().Create(context.TODO(), &storagev1.User{
+		ObjectMeta: metav1.ObjectMeta{Name: user},
+		Spec: storagev1.UserSpec{
+			Username: user,
+			Subject:  user,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", "", err
+	}
+
+	team := names.SimpleNameGenerator.GenerateName("team-")
+	_, err = kubeClient.Loft().StorageV1().Teams().Create(context.TODO(), &storagev1.Team{
+		ObjectMeta: metav1.ObjectMeta{Name: team},
+		Spec: storagev1.TeamSpec{
+			Users: []string{user},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return user, "", err
+	}
+
+	return user, team, nil
+}
+
+func deleteTeamWithUser(t *testing.T, kubeClient kube.Interface, user, team string) {
+	if team != "" {
+		if err := kubeClient.Loft().StorageV1().Teams().Delete(context.TODO(), team, metav1.DeleteOptions{}); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := kubeClient.Loft().StorageV1().Users().Delete(context.TODO(), user, metav1.DeleteOptions{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAccDataSourceProjectMembersCreate(configPath, project, user, team, clusterRole string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "test" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		members {
+			kind = "User"
+			group = "storage.loft.sh"
+			name = "%[3]s"
+			cluster_role = "%[5]s"
+		}
+		members {
+			kind = "Team"
+			group = "storage.loft.sh"
+			name = "%[4]s"
+			cluster_role = "loft-management-project-user"
+		}
+	}
+}
+`,
+		configPath,
+		project,
+		user,
+		team,
+		clusterRole,
+	)
+}
+
+func testAccDataSourceProjectMembersRead() string {
+	return `
+data "loft_project_members" "test" {
+	project = loft_project.test.metadata.0.name
+}
+`
+}
@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func TestAccDataSourceProjectQuotaUsage(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectQuotas(configPath, projectName, "requests.cpu", "2"),
+			},
+			{
+				Config: testAccResourceProjectQuotas(configPath, projectName, "requests.cpu", "2") +
+					testAccDataSourceProjectQuotaUsageRead(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.loft_project_quota_usage.test", "id", projectName),
+					resource.TestCheckResourceAttr("data.loft_project_quota_usage.test", "project", projectName),
+					resource.TestCheckResourceAttr("data.loft_project_quota_usage.test", "project_limit.requests.cpu", "2"),
+					resource.TestCheckResourceAttrSet("data.loft_project_quota_usage.test", "users.#"),
+					resource.TestCheckResourceAttrSet("data.loft_project_quota_usage.test", "clusters.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceProjectQuotaUsageRead() string {
+	return `
+data "loft_project_quota_usage" "test" {
+	project = loft_project.quotas.metadata.0.name
+}
+`
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func TestAccDataSourceProjectClustersAndTemplates(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+	user := "admin"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectAllowedCreate(configPath, projectName, user),
+			},
+			{
+				Config: testAccDataSourceProjectAllowedCreate(configPath, projectName, user) +
+					testAccDataSourceProjectAllowedRead(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.loft_project_clusters.test", "id", projectName),
+					resource.TestCheckResourceAttr("data.loft_project_clusters.test", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.loft_project_clusters.test", "clusters.0.name", "loft-cluster"),
+					resource.TestCheckResourceAttr("data.loft_project_templates.test", "id", projectName),
+					resource.TestCheckResourceAttr("data.loft_project_templates.test", "space_templates.#", "1"),
+					resource.TestCheckResourceAttr("data.loft_project_templates.test", "space_templates.0.name", "isolated-space"),
+					resource.TestCheckResourceAttr("data.loft_project_templates.test", "virtual_cluster_templates.#", "1"),
+					resource.TestCheckResourceAttr("data.loft_project_templates.test", "virtual_cluster_templates.0.name", "isolated-vcluster"),
+					resource.TestCheckResourceAttr("data.loft_project_templates.test", "default_virtual_cluster_template", "isolated-vcluster"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceProjectAllowedCreate(configPath, project, user string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "test" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		allowed_clusters {
+			name = "loft-cluster"
+		}
+		allowed_templates {
+			kind = "SpaceTemplate"
+			group = "storage.loft.sh"
+			name = "isolated-space"
+		}
+		allowed_templates {
+			kind = "VirtualClusterTemplate"
+			group = "storage.loft.sh"
+			name = "isolated-vcluster"
+			is_default = true
+		}
+	}
+}
+`,
+		configPath,
+		project,
+		user,
+	)
+}
+
+func testAccDataSourceProjectAllowedRead() string {
+	return `
+data "loft_project_clusters" "test" {
+	project = loft_project.test.metadata.0.name
+}
+
+data "loft_project_templates" "test" {
+	project = loft_project.test.metadata.0.name
+}
+`
+}
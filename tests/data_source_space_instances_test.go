@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func TestAccDataSourceSpaceInstances_filter(t *testing.T) {
+	project := "default"
+	user := "admin"
+	prodName := names.SimpleNameGenerator.GenerateName("myspace-prod-")
+	devName := names.SimpleNameGenerator.GenerateName("myspace-dev-")
+	selector := names.SimpleNameGenerator.GenerateName("filter-")
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      spaceInstanceCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSpaceInstancesCreate(configPath, project, user, prodName, devName, selector),
+			},
+			{
+				Config: testAccDataSourceSpaceInstancesCreate(configPath, project, user, prodName, devName, selector) +
+					testAccDataSourceSpaceInstancesFiltered(project, user, selector),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.loft_space_instances.prod", "space_instances.#", "1"),
+					resource.TestCheckResourceAttr("data.loft_space_instances.prod", "space_instances.0.metadata.0.name", prodName),
+					resource.TestCheckResourceAttr("data.loft_space_instances.prod", "space_instances.0.metadata.0.namespace", "loft-p-"+project),
+					resource.TestCheckResourceAttr("data.loft_space_instances.prod", "space_instances.0.metadata.0.labels.stage", "prod"),
+					resource.TestCheckResourceAttr("data.loft_space_instances.prod", "space_instances.0.spec.0.owner.0.user", user),
+					resource.TestCheckResourceAttr("data.loft_space_instances.prod", "space_instances.0.spec.0.template_ref.0.name", "isolated-space"),
+					resource.TestCheckResourceAttr("data.loft_space_instances.all", "space_instances.#", "2"),
+					resource.TestCheckResourceAttr("data.loft_space_instances.other_template", "space_instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSpaceInstancesCreate(configPath, project, user, prodName, devName, selector string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_space_instance" "prod" {
+	metadata {
+		namespace = "loft-p-%[2]s"
+		name = "%[4]s"
+		labels = {
+			filter = "%[6]s"
+			stage = "prod"
+		}
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		template_ref {
+			name = "isolated-space"
+		}
+	}
+}
+
+resource "loft_space_instance" "dev" {
+	metadata {
+		namespace = "loft-p-%[2]s"
+		name = "%[5]s"
+		labels = {
+			filter = "%[6]s"
+			stage = "dev"
+		}
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		template_ref {
+			name = "isolated-space"
+		}
+	}
+}
+`,
+		configPath,
+		project,
+		user,
+		prodName,
+		devName,
+		selector,
+	)
+}
+
+func testAccDataSourceSpaceInstancesFiltered(project, user, selector string) string {
+	return fmt.Sprintf(`
+data "loft_space_instances" "prod" {
+	namespace = "loft-p-%[1]s"
+	label_selector = "filter=%[3]s,stage=prod"
+}
+
+data "loft_space_instances" "all" {
+	label_selector = "filter=%[3]s"
+	owner_user = "%[2]s"
+	template = "isolated-space"
+}
+
+data "loft_space_instances" "other_template" {
+	label_selector = "filter=%[3]s"
+	template = "other-template"
+}
+`,
+		project,
+		user,
+		selector,
+	)
+}
@@ -74,6 +74,8 @@ data:
 					checkSpaceByName("data.loft_spaces.all", space1Name, "cluster", clusterName),
 					checkSpaceByName("data.loft_spaces.all", space1Name, "team", ""),
 					checkSpaceByName("data.loft_spaces.all", space1Name, "user", user),
+					checkSpaceByName("data.loft_spaces.all", space1Name, "sleep_after", "3600"),
+					checkSpaceByName("data.loft_spaces.all", space1Name, "labels.some.domain/owner", user),
 					checkSpaceByName("data.loft_spaces.all", space2Name, "name", space2Name),
 					checkSpaceByName("data.loft_spaces.all", space2Name, "cluster", clusterName),
 					checkSpaceByName("data.loft_spaces.all", space2Name, "team", team),
@@ -88,6 +90,16 @@ data:
 					checkSpaceByName("data.loft_spaces.all", space4Name, "objects", objects),
 				),
 			},
+			{
+				Config: testAccDataSourceSpacesCreate(configPath, clusterName, space1Name, user, space2Name, team, space3Name, annotation, space4Name, objects) +
+					testAccDataSourceSpacesFiltered(clusterName, user, team),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.loft_spaces.labeled", "spaces.#", "1"),
+					resource.TestCheckResourceAttr("data.loft_spaces.labeled", "spaces.0.name", space1Name),
+					checkSpaceByName("data.loft_spaces.team", space2Name, "team", team),
+					resource.TestCheckResourceAttr("data.loft_spaces.team", "spaces.0.user", ""),
+				),
+			},
 		},
 	})
 }
@@ -121,6 +133,10 @@ resource "loft_space" "test_user" {
 	name = "%[3]s"
 	cluster = "%[2]s"
 	user = "%[4]s"
+	sleep_after = "1h"
+	labels = {
+		"some.domain/owner" = "%[4]s"
+	}
 }
 
 resource "loft_space" "test_team" {
@@ -167,6 +183,24 @@ data "loft_spaces" "all" {
 	)
 }
 
+func testAccDataSourceSpacesFiltered(clusterName, user, team string) string {
+	return fmt.Sprintf(`
+data "loft_spaces" "labeled" {
+	cluster = "%[1]s"
+	label_selector = "some.domain/owner=%[2]s"
+}
+
+data "loft_spaces" "team" {
+	cluster = "%[1]s"
+	team = "%[3]s"
+}
+`,
+		clusterName,
+		user,
+		team,
+	)
+}
+
 func checkSpaceByName(moduleName, spaceName, key, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		spacePath := ""
@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	"k8s.io/apiserver/pkg/storage/names"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAccResourceProjectAccess(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+	user := "admin"
+	team := "loft-admins"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectAccessCreate(configPath, projectName, user, team, `["get"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_access.security", "id", projectName+"/security"),
+					checkProject(configPath, projectName, hasAccessRule("security", []string{"get", "update"})),
+					checkProject(configPath, projectName, hasAccessRule("team", []string{"get"})),
+				),
+			},
+			{
+				ResourceName:      "loft_project_access.team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceProjectAccessCreate(configPath, projectName, user, team, `["get", "update"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_access.team", "verbs.#", "2"),
+					checkProject(configPath, projectName, hasAccessRule("security", []string{"get", "update"})),
+					checkProject(configPath, projectName, hasAccessRule("team", []string{"get", "update"})),
+				),
+			},
+			{
+				Config:      testAccResourceProjectAccessAuthoritative(configPath, names.SimpleNameGenerator.GenerateName("project-"), user),
+				ExpectError: regexp.MustCompile("are managed exclusively by its `access`"),
+			},
+		},
+	})
+}
+
+func hasAccessRule(name string, verbs []string) func(obj ctrlclient.Object) error {
+	return func(obj ctrlclient.Object) error {
+		project, ok := obj.(*managementv1.Project)
+		if !ok {
+			return fmt.Errorf("unexpected object %T", obj)
+		}
+
+		for _, rule := range project.Spec.Access {
+			if rule.Name == name {
+				if !reflect.DeepEqual(rule.Verbs, verbs) {
+					return fmt.Errorf("%s: access rule %q has verbs %v, expected %v", obj.GetName(), name, rule.Verbs, verbs)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s: access rule %q not found in %v", obj.GetName(), name, project.Spec.Access)
+	}
+}
+
+func testAccResourceProjectAccessCreate(configPath, project, user, team, teamVerbs string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "test" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+	}
+}
+
+resource "loft_project_access" "security" {
+	project = loft_project.test.metadata.0.name
+	name = "security"
+	verbs = ["get", "update"]
+	subresources = ["*"]
+	users = ["%[3]s"]
+}
+
+resource "loft_project_access" "team" {
+	project = loft_project.test.metadata.0.name
+	name = "team"
+	verbs = %[5]s
+	subresources = ["members", "clusters", "templates"]
+	teams = ["%[4]s"]
+}
+`,
+		configPath,
+		project,
+		user,
+		team,
+		teamVerbs,
+	)
+}
+
+func testAccResourceProjectAccessAuthoritative(configPath, project, user string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "authoritative" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		access {
+			name = "owner"
+			verbs = ["*"]
+			subresources = ["*"]
+			users = ["%[3]s"]
+		}
+	}
+}
+
+resource "loft_project_access" "rejected" {
+	project = loft_project.authoritative.metadata.0.name
+	name = "security"
+	verbs = ["get"]
+	subresources = ["*"]
+	users = ["%[3]s"]
+}
+`,
+		configPath,
+		project,
+		user,
+	)
+}
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	"k8s.io/apiserver/pkg/storage/names"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAccResourceProjectAllowedClusterAndTemplate(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+	user := "admin"
+	cluster := "loft-cluster"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectAllowedCreate(configPath, projectName, user, cluster, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_allowed_cluster.test", "id", projectName+"/"+cluster),
+					resource.TestCheckResourceAttr("loft_project_allowed_template.test", "id", projectName+"/VirtualClusterTemplate/isolated-vcluster"),
+					checkProject(configPath, projectName, hasAllowedCluster(cluster)),
+					checkProject(configPath, projectName, hasAllowedTemplate("VirtualClusterTemplate", "isolated-vcluster", false)),
+				),
+			},
+			{
+				ResourceName:      "loft_project_allowed_cluster.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "loft_project_allowed_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceProjectAllowedCreate(configPath, projectName, user, cluster, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_allowed_template.test", "is_default", "true"),
+					checkProject(configPath, projectName, hasAllowedCluster(cluster)),
+					checkProject(configPath, projectName, hasAllowedTemplate("VirtualClusterTemplate", "isolated-vcluster", true)),
+				),
+			},
+			{
+				Config:      testAccResourceProjectAllowedAuthoritative(configPath, names.SimpleNameGenerator.GenerateName("project-"), user, cluster),
+				ExpectError: regexp.MustCompile("are managed exclusively by its `allowed_clusters`"),
+			},
+		},
+	})
+}
+
+func hasAllowedCluster(name string) func(obj ctrlclient.Object) error {
+	return func(obj ctrlclient.Object) error {
+		project, ok := obj.(*managementv1.Project)
+		if !ok {
+			return fmt.Errorf("unexpected object %T", obj)
+		}
+
+		for _, cluster := range project.Spec.AllowedClusters {
+			if cluster.Name == name {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s: allowed cluster %q not found in %v", obj.GetName(), name, project.Spec.AllowedClusters)
+	}
+}
+
+func hasAllowedTemplate(kind, name string, isDefault bool) func(obj ctrlclient.Object) error {
+	return func(obj ctrlclient.Object) error {
+		project, ok := obj.(*managementv1.Project)
+		if !ok {
+			return fmt.Errorf("unexpected object %T", obj)
+		}
+
+		for _, template := range project.Spec.AllowedTemplates {
+			if template.Kind == kind && template.Name == name && template.IsDefault == isDefault {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s: allowed %s %q not found in %v", obj.GetName(), kind, name, project.Spec.AllowedTemplates)
+	}
+}
+
+func testAccResourceProjectAllowedCreate(configPath, project, user, cluster string, isDefault bool) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "test" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+	}
+}
+
+resource "loft_project_allowed_cluster" "test" {
+	project = loft_project.test.metadata.0.name
+	cluster = "%[4]s"
+}
+
+resource "loft_project_allowed_template" "test" {
+	project = loft_project.test.metadata.0.name
+	kind = "VirtualClusterTemplate"
+	name = "isolated-vcluster"
+	is_default = %[5]t
+}
+`,
+		configPath,
+		project,
+		user,
+		cluster,
+		isDefault,
+	)
+}
+
+func testAccResourceProjectAllowedAuthoritative(configPath, project, user, cluster string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "authoritative" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		allowed_clusters {
+			name = "*"
+		}
+	}
+}
+
+resource "loft_project_allowed_cluster" "rejected" {
+	project = loft_project.authoritative.metadata.0.name
+	cluster = "%[4]s"
+}
+`,
+		configPath,
+		project,
+		user,
+		cluster,
+	)
+}
@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/loft-sh/loftctl/v3/pkg/client/naming"
+	"k8s.io/apiserver/pkg/storage/names"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAccResourceProjectImportSpace(t *testing.T) {
+	name := names.SimpleNameGenerator.GenerateName("mycluster-")
+	user := "admin"
+	project := "default"
+	cluster := "loft-cluster"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccSpaceCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSpaceCreateWithUser(configPath, user, cluster, name),
+				Check:  checkSpace(configPath, cluster, name, spaceHasUser(user)),
+			},
+			{
+				Config: testAccResourceSpaceCreateWithUser(configPath, user, cluster, name) + testAccResourceProjectImportSpace(project, cluster),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_import_space.test", "instance_id", naming.ProjectNamespace(project)+"/"+name),
+					checkSpaceInstance(configPath, project, name, func(obj ctrlclient.Object) error {
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceProjectImportSpace(projectName, clusterName string) string {
+	return fmt.Sprintf(`
+resource "loft_project_import_space" "test" {
+	project = "%s"
+	cluster = "%s"
+	space = loft_space.test_user.name
+}
+`,
+		projectName,
+		clusterName,
+	)
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/loft-sh/loftctl/v3/pkg/client/naming"
+	"k8s.io/apiserver/pkg/storage/names"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAccResourceProjectImportVirtualCluster(t *testing.T) {
+	name := names.SimpleNameGenerator.GenerateName("myvcluster-")
+	namespace := names.SimpleNameGenerator.GenerateName("namespace-")
+	user := "admin"
+	project := "default"
+	cluster := "loft-cluster"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	if err := createSpace(configPath, cluster, namespace); err != nil {
+		t.Fatal(err)
+	}
+	defer func(configPath, clusterName, spaceName string) {
+		if err := deleteSpace(configPath, clusterName, spaceName); err != nil {
+			t.Fatal(err)
+		}
+	}(configPath, cluster, namespace)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccVirtualClusterCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVirtualClusterCreateWithAnnotations(configPath, cluster, namespace, name, "imported"),
+				Check:  resource.TestCheckResourceAttr("loft_virtual_cluster.test_annotations", "name", name),
+			},
+			{
+				Config: testAccResourceVirtualClusterCreateWithAnnotations(configPath, cluster, namespace, name, "imported") + testAccResourceProjectImportVirtualCluster(project, cluster),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_import_virtual_cluster.test", "instance_id", naming.ProjectNamespace(project)+"/"+name),
+					checkVirtualClusterInstance(configPath, project, name, func(obj ctrlclient.Object) error {
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceProjectImportVirtualCluster(projectName, clusterName string) string {
+	return fmt.Sprintf(`
+resource "loft_project_import_virtual_cluster" "test" {
+	project = "%s"
+	cluster = "%s"
+	namespace = loft_virtual_cluster.test_annotations.namespace
+	virtual_cluster = loft_virtual_cluster.test_annotations.name
+}
+`,
+		projectName,
+		clusterName,
+	)
+}
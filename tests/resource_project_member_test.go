@@ -0,0 +1,281 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	managementv1 "github.com/loft-sh/api/v3/pkg/apis/management/v1"
+	"github.com/loft-sh/loftctl/v3/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/storage/names"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAccResourceProjectMember(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+	user := "admin"
+	team := "loft-admins"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectMemberCreate(configPath, projectName, user, team, "loft-management-project-user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_member.user", "id", projectName+"/User/"+user),
+					resource.TestCheckResourceAttr("loft_project_member.team", "id", projectName+"/Team/"+team),
+					checkProject(configPath, projectName, hasMember("User", user, "loft-management-project-user")),
+					checkProject(configPath, projectName, hasMember("Team", team, "loft-management-project-user")),
+				),
+			},
+			{
+				ResourceName:      "loft_project_member.team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceProjectMemberCreate(configPath, projectName, user, team, "loft-management-project-admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project_member.team", "cluster_role", "loft-management-project-admin"),
+					checkProject(configPath, projectName, hasMember("User", user, "loft-management-project-user")),
+					checkProject(configPath, projectName, hasMember("Team", team, "loft-management-project-admin")),
+				),
+			},
+			{
+				Config:      testAccResourceProjectMemberAuthoritative(configPath, names.SimpleNameGenerator.GenerateName("project-"), user),
+				ExpectError: regexp.MustCompile("are managed exclusively by its `members`"),
+			},
+		},
+	})
+}
+
+func TestAccResourceProjectMember_unannotatedProject(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+	user := "admin"
+	annotation := "terraform.loft.sh/authoritative-members"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectMemberUnannotated(configPath, projectName, user, "before"),
+				Check:  checkProject(configPath, projectName, hasAnnotation(annotation, "true")),
+			},
+			{
+				// projects created before the annotation existed keep their members
+				PreConfig: func() {
+					if err := removeProjectAnnotation(kubeClient, projectName, annotation); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccResourceProjectMemberUnannotated(configPath, projectName, user, "before"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "loft_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"spec.0.access",
+					"spec.0.allowed_clusters",
+					"spec.0.allowed_templates",
+				},
+			},
+			{
+				Config: testAccResourceProjectMemberUnannotated(configPath, projectName, user, "after"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project.test", "spec.0.members.#", "1"),
+					checkProject(configPath, projectName, hasAnnotation(annotation, "true")),
+					checkProject(configPath, projectName, hasMember("User", user, "loft-management-project-admin")),
+				),
+			},
+		},
+	})
+}
+
+func hasMember(kind, name, clusterRole string) func(obj ctrlclient.Object) error {
+	return func(obj ctrlclient.Object) error {
+		project, ok := obj.(*managementv1.Project)
+		if !ok {
+			return fmt.Errorf("unexpected object %T", obj)
+		}
+
+		for _, member := range project.Spec.Members {
+			if member.Kind == kind && member.Name == name && member.ClusterRole == clusterRole {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s: %s %q with cluster role %q not found in %v", obj.GetName(), kind, name, clusterRole, project.Spec.Members)
+	}
+}
+
+func testAccResourceProjectMemberCreate(configPath, project, user, team, teamClusterRole string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "test" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+	}
+}
+
+resource "loft_project_member" "user" {
+	project = loft_project.test.metadata.0.name
+	kind = "User"
+	name = "%[3]s"
+	cluster_role = "loft-management-project-user"
+}
+
+resource "loft_project_member" "team" {
+	project = loft_project.test.metadata.0.name
+	kind = "Team"
+	name = "%[4]s"
+	cluster_role = "%[5]s"
+}
+`,
+		configPath,
+		project,
+		user,
+		team,
+		teamClusterRole,
+	)
+}
+
+func testAccResourceProjectMemberAuthoritative(configPath, project, user string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "authoritative" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		members {
+			kind = "User"
+			group = "storage.loft.sh"
+			name = "%[3]s"
+			cluster_role = "loft-management-project-admin"
+		}
+	}
+}
+
+resource "loft_project_member" "rejected" {
+	project = loft_project.authoritative.metadata.0.name
+	kind = "Team"
+	name = "loft-admins"
+	cluster_role = "loft-management-project-user"
+}
+`,
+		configPath,
+		project,
+		user,
+	)
+}
+
+func removeProjectAnnotation(kubeClient kube.Interface, projectName, annotation string) error {
+	project, err := kubeClient.Loft().StorageV1().Projects().Get(context.TODO(), projectName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	delete(project.Annotations, annotation)
+	_, err = kubeClient.Loft().StorageV1().Projects().Update(context.TODO(), project, metav1.UpdateOptions{})
+	return err
+}
+
+func testAccResourceProjectMemberUnannotated(configPath, project, user, displayName string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "test" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		display_name = "%[4]s"
+		owner {
+			user = "%[3]s"
+		}
+		members {
+			kind = "User"
+			group = "storage.loft.sh"
+			name = "%[3]s"
+			cluster_role = "loft-management-project-admin"
+		}
+	}
+}
+`,
+		configPath,
+		project,
+		user,
+		displayName,
+	)
+}
@@ -154,6 +154,49 @@ func TestAccResourceProject_allProperties(t *testing.T) {
 	})
 }
 
+func TestAccResourceProject_quotas(t *testing.T) {
+	projectName := names.SimpleNameGenerator.GenerateName("project-")
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccProjectCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceProjectQuotas(configPath, projectName, "requests.cpuu", "1"),
+				ExpectError: regexp.MustCompile(`Unknown quota resource "requests.cpuu"`),
+			},
+			{
+				Config:      testAccResourceProjectQuotas(configPath, projectName, "requests.memory", "10 Gi"),
+				ExpectError: regexp.MustCompile(`Invalid quantity "10 Gi" for quota "requests.memory"`),
+			},
+			{
+				// the API might store the quantity as 1, which must not cause a diff
+				Config: testAccResourceProjectQuotas(configPath, projectName, "requests.cpu", "1000m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_project.quotas", "metadata.0.name", projectName),
+					resource.TestCheckResourceAttr("loft_project.quotas", "spec.0.quotas.0.project.spaceinstances", "5"),
+				),
+			},
+			{
+				Config:   testAccResourceProjectQuotas(configPath, projectName, "requests.cpu", "1"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccResourceProjectNoName(configPath string) string {
 	return fmt.Sprintf(`
 	terraform {
@@ -311,6 +354,9 @@ func testAccProjectCheckDestroy(kubeClient kube.Interface) func(s *terraform.Sta
 	return func(s *terraform.State) error {
 		var projects []string
 		for _, resourceState := range s.RootModule().Resources {
+			if resourceState.Type != "loft_project" {
+				continue
+			}
 			projects = append(projects, resourceState.Primary.ID)
 		}
 
@@ -329,3 +375,38 @@ func testAccProjectCheckDestroy(kubeClient kube.Interface) func(s *terraform.Sta
 		return nil
 	}
 }
+
+func testAccResourceProjectQuotas(configPath, project, key, value string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "quotas" {
+	metadata {
+		name = "%[2]s"
+	}
+	spec {
+		quotas {
+			project = {
+				"spaceinstances" = "5"
+				"%[3]s" = "%[4]s"
+			}
+		}
+	}
+}
+`,
+		configPath,
+		project,
+		key,
+		value,
+	)
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	agentv1 "github.com/loft-sh/agentapi/v3/pkg/apis/loft/cluster/v1"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func TestAccResourceSpaceInstance_sleepMode(t *testing.T) {
+	name := names.SimpleNameGenerator.GenerateName("myspace-")
+	user := "admin"
+	project := "default"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      spaceInstanceCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSpaceInstanceSleepMode(configPath, project, user, name, `
+		sleep_schedule = "every evening"`),
+				ExpectError: regexp.MustCompile(`invalid cron expression "every evening"`),
+			},
+			{
+				Config: testAccResourceSpaceInstanceSleepMode(configPath, project, user, name, `
+		sleep_after = "1h"
+		sleep_schedule = "0 18 * * 1-5"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_space_instance.test", "spec.0.sleep_after", "3600"),
+					resource.TestCheckResourceAttr("loft_space_instance.test", "spec.0.sleep_schedule", "0 18 * * 1-5"),
+					resource.TestCheckResourceAttr("loft_space_instance.test", "spec.0.template_ref.0.name", "isolated-space"),
+					checkSpaceInstance(configPath, project, name, hasAnnotation(agentv1.SleepModeSleepAfterAnnotation, "3600")),
+					checkSpaceInstance(configPath, project, name, hasAnnotation(agentv1.SleepModeSleepScheduleAnnotation, "0 18 * * 1-5")),
+				),
+			},
+			{
+				Config: testAccResourceSpaceInstanceSleepMode(configPath, project, user, name, `
+		sleep_after = "30m"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_space_instance.test", "spec.0.sleep_after", "1800"),
+					resource.TestCheckResourceAttr("loft_space_instance.test", "spec.0.sleep_schedule", ""),
+					checkSpaceInstance(configPath, project, name, hasAnnotation(agentv1.SleepModeSleepAfterAnnotation, "1800")),
+					checkSpaceInstance(configPath, project, name, hasAnnotation(agentv1.SleepModeSleepScheduleAnnotation, "")),
+				),
+			},
+			{
+				ResourceName:      "loft_space_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"metadata.0.generation",
+					"metadata.0.resource_version",
+					// imports read the access rules Loft stores when none are configured
+					"spec.0.access",
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceSpaceInstanceSleepMode(configPath, project, user, spaceName, sleepMode string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_space_instance" "test" {
+	metadata {
+		namespace = "loft-p-%[2]s"
+		name = "%[4]s"
+	}
+	spec {
+		owner {
+			user = "%[3]s"
+		}
+		template_ref {
+			name = "isolated-space"
+		}%[5]s
+	}
+}
+`,
+		configPath,
+		project,
+		user,
+		spaceName,
+		sleepMode,
+	)
+}
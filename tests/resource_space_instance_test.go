@@ -74,6 +74,38 @@ func TestAccResourceSpaceInstance_noNamespace(t *testing.T) {
 	})
 }
 
+func TestAccResourceSpaceInstance_templateAndTemplateRef(t *testing.T) {
+	name := names.SimpleNameGenerator.GenerateName("mycluster-")
+	project := "default"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSpaceInstanceTemplateAndTemplateRef(configPath, project, name),
+				ExpectError: regexp.MustCompile("Conflicting configuration arguments"),
+			},
+			{
+				Config:      testAccResourceSpaceInstanceUserAndTeamOwner(configPath, project, name),
+				ExpectError: regexp.MustCompile("Invalid combination of arguments"),
+			},
+		},
+	})
+}
+
 func TestAccResourceSpaceInstance_minimal(t *testing.T) {
 	name := names.SimpleNameGenerator.GenerateName("mycluster-")
 	user := "admin"
@@ -112,6 +144,8 @@ func TestAccResourceSpaceInstance_minimal(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"metadata.0.generation",
 					"metadata.0.resource_version",
+					// imports read the access rules Loft stores when none are configured
+					"spec.0.access",
 				},
 			},
 		},
@@ -320,6 +354,177 @@ func TestAccResourceSpaceInstance_withTemplateRef(t *testing.T) {
 	})
 }
 
+func TestAccResourceSpaceInstance_importLegacySpace(t *testing.T) {
+	name := names.SimpleNameGenerator.GenerateName("mycluster-")
+	user := "admin"
+	project := "default"
+	cluster := "loft-cluster"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccSpaceCheckDestroy(kubeClient),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSpaceCreateWithUser(configPath, user, cluster, name),
+				Check:  checkSpace(configPath, cluster, name, spaceHasUser(user)),
+			},
+			{
+				Config:        testAccResourceSpaceCreateWithUser(configPath, user, cluster, name) + testAccResourceSpaceInstanceImported(project, name),
+				ResourceName:  "loft_space_instance.imported",
+				ImportState:   true,
+				ImportStateId: strings.Join([]string{project, cluster, name}, "/"),
+				ExpectError:   regexp.MustCompile(`has not been imported into project`),
+			},
+			{
+				Config: testAccResourceSpaceCreateWithUser(configPath, user, cluster, name) + testAccResourceProjectImportSpace(project, cluster),
+				Check:  resource.TestCheckResourceAttr("loft_project_import_space.test", "instance_id", naming.ProjectNamespace(project)+"/"+name),
+			},
+			{
+				Config:        testAccResourceSpaceCreateWithUser(configPath, user, cluster, name) + testAccResourceProjectImportSpace(project, cluster) + testAccResourceSpaceInstanceImported(project, name),
+				ResourceName:  "loft_space_instance.imported",
+				ImportState:   true,
+				ImportStateId: strings.Join([]string{project, cluster, name}, "/"),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported space instance, got %d", len(states))
+					}
+
+					expectedID := naming.ProjectNamespace(project) + "/" + name
+					if states[0].ID != expectedID {
+						return fmt.Errorf("expected imported space instance %s, got %s", expectedID, states[0].ID)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceSpaceInstanceImported(projectName, spaceName string) string {
+	return fmt.Sprintf(`
+resource "loft_space_instance" "imported" {
+	metadata {
+		namespace = "loft-p-%s"
+		name = "%s"
+	}
+	spec {}
+}
+`,
+		projectName,
+		spaceName,
+	)
+}
+
+func TestAccResourceSpaceInstance_migrateProject(t *testing.T) {
+	name := names.SimpleNameGenerator.GenerateName("mycluster-")
+	targetProject := names.SimpleNameGenerator.GenerateName("myproject-")
+	user := "admin"
+	project := "default"
+
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, accessKey, configPath, err := loginUser(kubeClient, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logout(t, kubeClient, accessKey)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			spaceInstanceCheckDestroy(kubeClient),
+			testAccProjectCheckDestroy(kubeClient),
+		),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSpaceInstanceMigrate(configPath, project, targetProject, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_space_instance.test_user", "id", naming.ProjectNamespace(project)+"/"+name),
+					checkSpaceInstance(configPath, project, name, hasUser(user)),
+				),
+			},
+			{
+				Config: testAccResourceSpaceInstanceMigrate(configPath, targetProject, targetProject, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("loft_space_instance.test_user", "id", naming.ProjectNamespace(targetProject)+"/"+name),
+					resource.TestCheckResourceAttr("loft_space_instance.test_user", "metadata.0.namespace", naming.ProjectNamespace(targetProject)),
+					checkSpaceInstance(configPath, targetProject, name, hasUser(user)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSpaceInstanceMigrate(configPath, project, targetProject, spaceName string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%[1]s"
+}
+
+resource "loft_project" "target" {
+	metadata {
+		name = "%[3]s"
+	}
+	spec {
+		allowed_clusters {
+			name = "*"
+		}
+		allowed_templates {
+			kind  = "SpaceTemplate"
+			group = "storage.loft.sh"
+			name  = "*"
+		}
+		owner {
+			user = "admin"
+		}
+	}
+}
+
+resource "loft_space_instance" "test_user" {
+	metadata {
+		namespace = "loft-p-%[2]s"
+		name = "%[4]s"
+	}
+	spec {
+		template_ref {
+			name = "isolated-space"
+		}
+	}
+
+	depends_on = [loft_project.target]
+}
+`,
+		configPath,
+		project,
+		targetProject,
+		spaceName,
+	)
+}
+
 func testAccResourceSpaceInstanceNoName(configPath, projectName string) string {
 	return fmt.Sprintf(`
 
@@ -349,6 +554,77 @@ func testAccResourceSpaceInstanceNoName(configPath, projectName string) string {
 	)
 }
 
+func testAccResourceSpaceInstanceTemplateAndTemplateRef(configPath, projectName, spaceName string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%s"
+}
+
+resource "loft_space_instance" "test" {
+	metadata {
+		namespace = "loft-p-%s"
+		name = "%s"
+	}
+	spec {
+		template {
+			metadata {}
+		}
+		template_ref {
+			name = "isolated-space"
+		}
+	}
+}
+`,
+		configPath,
+		projectName,
+		spaceName,
+	)
+}
+
+func testAccResourceSpaceInstanceUserAndTeamOwner(configPath, projectName, spaceName string) string {
+	return fmt.Sprintf(`
+terraform {
+	required_providers {
+		loft = {
+			source = "registry.terraform.io/loft-sh/loft"
+		}
+	}
+}
+
+provider "loft" {
+	config_path = "%s"
+}
+
+resource "loft_space_instance" "test" {
+	metadata {
+		namespace = "loft-p-%s"
+		name = "%s"
+	}
+	spec {
+		owner {
+			user = "admin"
+			team = "loft-admins"
+		}
+		template_ref {
+			name = "isolated-space"
+		}
+	}
+}
+`,
+		configPath,
+		projectName,
+		spaceName,
+	)
+}
+
 func testAccResourceSpaceInstanceNoNamespace(configPath, spaceName string) string {
 	return fmt.Sprintf(`
 
@@ -642,6 +918,9 @@ func spaceInstanceCheckDestroy(kubeClient kube.Interface) func(s *terraform.Stat
 	return func(s *terraform.State) error {
 		var spaces []string
 		for _, resourceState := range s.RootModule().Resources {
+			if resourceState.Type != "loft_space_instance" {
+				continue
+			}
 			spaces = append(spaces, resourceState.Primary.ID)
 		}
 